@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/parse"
+	"j-parser-go/stats"
+)
+
+var careersCommand = &command{
+	name:    "careers",
+	summary: "Follow every contestant across the archive's seasons: appearances, wins, win streaks and total winnings.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season in the archive)")
+		order := fs.String("sort", stats.ByWinnings, "Order to list contestants in: winnings, wins, streak (longest win streak) or appearances")
+		minAppearances := fs.Int("min-appearances", 1, "Only contestants who played at least this many games")
+		format := fs.String("format", "csv", "Output format: csv or json (with every appearance)")
+		output := fs.String("o", "", "Write the report to this file instead of standard output")
+		return func(e *env) error {
+			var write func(io.Writer, []stats.Career) error
+			switch *format {
+			case "csv":
+				write = stats.WriteCareersCSV
+			case "json":
+				write = stats.WriteCareersJSON
+			default:
+				return fmt.Errorf("unknown format %q (want csv or json)", *format)
+			}
+			var selected []string
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if selected, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+
+			// the CSVs have no contestants, so the games come from the archive
+			games, err := parse.Games(exportParseOptions(e, selected))
+			if err != nil {
+				return err
+			}
+			careers := stats.Careers(games)
+			if err := stats.SortCareers(careers, *order); err != nil {
+				return err
+			}
+			kept := careers[:0]
+			for _, c := range careers {
+				if len(c.Appearances) >= *minAppearances {
+					kept = append(kept, c)
+				}
+			}
+			return writeOutput(*output, func(w io.Writer) error { return write(w, kept) })
+		}
+	},
+}
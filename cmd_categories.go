@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/stats"
+)
+
+var categoriesCommand = &command{
+	name:    "categories",
+	summary: "List every category in the parsed CSVs with how often and when it was played.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season with a CSV)")
+		order := fs.String("sort", stats.ByCount, "Order to list categories in: count (most played first), name or first (first air date)")
+		minCount := fs.Int("min-count", 1, "Only categories played at least this many times")
+		format := fs.String("format", "csv", "Output format: csv or json")
+		output := fs.String("o", "", "Write the report to this file instead of standard output")
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			var write func(io.Writer, []stats.Category) error
+			switch *format {
+			case "csv":
+				write = stats.WriteCategoriesCSV
+			case "json":
+				write = stats.WriteCategoriesJSON
+			default:
+				return fmt.Errorf("unknown format %q (want csv or json)", *format)
+			}
+			opts := stats.Options{CSVDir: *csvDir}
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if opts.Seasons, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+
+			cats, err := stats.Categories(opts)
+			if err != nil {
+				return err
+			}
+			if err := stats.SortCategories(cats, *order); err != nil {
+				return err
+			}
+			kept := cats[:0]
+			for _, c := range cats {
+				if c.Count >= *minCount {
+					kept = append(kept, c)
+				}
+			}
+			return writeOutput(*output, func(w io.Writer) error { return write(w, kept) })
+		}
+	},
+}
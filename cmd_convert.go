@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/export"
+)
+
+var convertCommand = &command{
+	name:    "convert",
+	summary: "Convert already-parsed clues between CSV, JSON, Arrow and Parquet without re-parsing the archive.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		in := fs.String("in", "parsed-csv", "Directory of season CSVs written by parse, or a season CSV, JSON, Arrow (.arrow, .feather) or Parquet file to convert")
+		to := fs.String("to", "", "Format to convert to: csv, json, arrow or parquet")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season in the input)")
+		columns := fs.String("columns", "", "Comma-separated list of columns to write with -to csv or json, in order (default: every CSV column)")
+		output := fs.String("o", "", "Write the converted clues to this file instead of standard output")
+		return func(e *env) error {
+			if e.fromConfig("in") && e.cfg.OutDir != "" {
+				*in = e.cfg.OutDir
+			}
+			var selected []string
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if selected, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			names := splitList(*columns)
+			var write func(io.Writer, []dataset.Clue) error
+			switch *to {
+			case "csv", "json":
+				// the writer waits for the clues, whose columns depend on
+				// them
+				if err := dataset.CheckColumns(names); err != nil {
+					return fmt.Errorf("invalid -columns: %v", err)
+				}
+			case "arrow", "parquet":
+				if len(names) > 0 {
+					return errors.New("-columns needs -to csv or json")
+				}
+				write = export.WriteArrow
+				if *to == "parquet" {
+					write = export.WriteParquet
+				}
+			case "":
+				return errors.New("convert needs -to, the format to convert to")
+			default:
+				return fmt.Errorf("unknown format %q (want csv, json, arrow or parquet)", *to)
+			}
+
+			clues, err := readClues(*in, selected)
+			if err != nil {
+				return err
+			}
+			if write == nil {
+				if write, err = clueWriter(*to, outputColumns(names, clues)); err != nil {
+					return err
+				}
+			}
+			return writeOutput(*output, func(w io.Writer) error { return write(w, clues) })
+		}
+	},
+}
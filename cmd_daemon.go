@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"j-parser-go/metrics"
+)
+
+var daemonCommand = &command{
+	name:    "daemon",
+	summary: "Keep the archive up to date: sync the current season now and again every -interval, appending new games to its CSV.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		df := registerDownloadFlags(fs)
+		pf := registerParseFlags(fs)
+		uf := registerUploadFlags(fs)
+		nf := registerNotifyFlags(fs)
+		interval := fs.Duration("interval", 24*time.Hour, "Time between syncs, from the end of one to the start of the next")
+		metricsAddr := fs.String("metrics-addr", "", "Serve Prometheus metrics on /metrics at this address, e.g. :9090")
+		return func(e *env) error {
+			if e.fromConfig("interval") && e.cfg.Interval != 0 {
+				*interval = e.cfg.Interval
+			}
+			if e.fromConfig("metrics-addr") && e.cfg.MetricsAddr != "" {
+				*metricsAddr = e.cfg.MetricsAddr
+			}
+			if *interval <= 0 {
+				return errors.New("-interval must be positive")
+			}
+			if e.common.dryRun {
+				seasons, opts, err := df.options(e)
+				if err != nil {
+					return err
+				}
+				return syncPlan(seasons, opts, pf.options(e), false)
+			}
+
+			// the first interrupt stops the daemon once the sync under way
+			// is done; a second one stops it straight away
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				stop()
+				slog.Warn("stopping once no sync is under way; interrupt again to quit now")
+			}()
+
+			if *metricsAddr != "" {
+				// listening here rather than in the goroutine, so that an
+				// address in use stops the daemon before the first sync
+				ln, err := net.Listen("tcp", *metricsAddr)
+				if err != nil {
+					return fmt.Errorf("error serving metrics: %v", err)
+				}
+				defer ln.Close()
+				mux := http.NewServeMux()
+				mux.Handle("GET /metrics", metrics.Handler())
+				go http.Serve(ln, mux)
+				slog.Info("serving metrics", "url", "http://"+ln.Addr().String()+"/metrics")
+			}
+
+			slog.Info("starting daemon", "interval", *interval)
+			for {
+				nf.started = time.Now()
+				// seasons are worked out again every time, so that with no
+				// -seasons a new season is picked up once it starts
+				seasons, opts, err := df.options(e)
+				if err == nil {
+					parseOpts := pf.options(e)
+					// only new and changed episodes are parsed, the rest of
+					// the CSV is kept as it is
+					parseOpts.Incremental = true
+					// the sync under way isn't interrupted, see above
+					err = runSync(context.Background(), e, "daemon", seasons, opts, parseOpts, pf, uf, nf, false)
+				}
+				next := time.Now().Add(*interval).Format(time.DateTime)
+				if err != nil {
+					slog.Error("sync failed", "err", err, "next", next)
+				} else {
+					slog.Info("sync complete", "next", next)
+				}
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-time.After(*interval):
+				}
+			}
+		}
+	},
+}
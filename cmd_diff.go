@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/dataset"
+)
+
+var diffCommand = &command{
+	name:    "diff",
+	summary: "Report the games added and removed and the clues changed between two directories of parsed CSVs.",
+	args:    "<old-dir> <new-dir>",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to compare (default: every season with a CSV in either)")
+		format := fs.String("format", "text", "Output format: text (a changelog) or json")
+		output := fs.String("o", "", "Write the changes to this file instead of standard output")
+		return func(e *env) error {
+			if len(e.args) != 2 {
+				return fmt.Errorf("diff needs two directories, the old and the new CSVs; got %d arguments", len(e.args))
+			}
+			if *format != "text" && *format != "json" {
+				return fmt.Errorf("unknown format %q (want text or json)", *format)
+			}
+			var selected []string
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if selected, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			var sets [2][]dataset.Clue
+			for i, dir := range e.args {
+				var err error
+				if sets[i], err = dataset.Load(dataset.Options{Dir: dir, Seasons: selected}); err != nil {
+					return err
+				}
+			}
+			changes := dataset.Diff(sets[0], sets[1])
+			return writeOutput(*output, func(w io.Writer) error {
+				if *format == "json" {
+					return changes.WriteJSON(w)
+				}
+				changes.WriteText(w)
+				return nil
+			})
+		}
+	},
+}
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"j-parser-go/download"
+)
+
+var downloadCommand = &command{
+	name:    "download",
+	summary: "Download episode HTML for the given seasons into the archive directory.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		df := registerDownloadFlags(fs)
+		nf := registerNotifyFlags(fs)
+		return func(e *env) error {
+			seasons, opts, err := df.options(e)
+			if err != nil {
+				return err
+			}
+			if e.common.dryRun {
+				plan, err := download.New(opts).Plan(seasons)
+				if err != nil {
+					return err
+				}
+				plan.Write(os.Stdout)
+				return nil
+			}
+			ctx, stop := interruptContext()
+			defer stop()
+			res, err := download.RunContext(ctx, seasons, opts)
+			err = downloadInterrupted(res, err)
+			nf.send(e, "download", err, &res, nil)
+			return err
+		}
+	},
+}
+
+// returns the context download and sync run under: the first interrupt
+// stops them between episodes, once the manifest is saved, and a second one
+// stops them straight away
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
+
+// tells how to pick up the seasons an interrupted download didn't finish,
+// and marks its error as an interruption
+func downloadInterrupted(res download.Result, err error) error {
+	if !errors.Is(err, context.Canceled) {
+		return err
+	}
+	if n := len(res.Unfinished); n > 0 {
+		seasons := "seasons " + strings.Join(res.Unfinished, ", ")
+		if n == 1 {
+			seasons = "season " + res.Unfinished[0]
+		}
+		fmt.Printf("Download interrupted with %s unfinished after %d new episodes; run again with -seasons=%s to download the rest.\n",
+			seasons, res.Downloaded, strings.Join(res.Unfinished, ","))
+	}
+	return fmt.Errorf("interrupted: %w", err)
+}
+
+// downloadFlags are shared by the commands that download episodes
+type downloadFlags struct {
+	seasons   string
+	refresh   bool
+	olderThan string
+	rate      float64
+	baseURL   string
+	noHTTP2   bool
+	seasonTTL string
+	force     bool
+	scores    bool
+	players   bool
+}
+
+func registerDownloadFlags(fs *flag.FlagSet) *downloadFlags {
+	df := &downloadFlags{}
+	fs.StringVar(&df.seasons, "seasons", "", "Comma-separated list of seasons to download (e.g., 1,2,superjeopardy), or \"all\" for every season")
+	fs.BoolVar(&df.refresh, "refresh", false, "Re-download episodes that have already been saved")
+	fs.StringVar(&df.olderThan, "older-than", "", "With -refresh, only re-download files older than this age (e.g., 30d, 12h)")
+	fs.StringVar(&df.baseURL, "base-url", download.DefaultBaseURL, "J! Archive's address, e.g. for a mirror; redirects to https or www. are followed")
+	fs.BoolVar(&df.noHTTP2, "no-http2", false, "Only speak HTTP/1.1 to J! Archive, even if it offers HTTP/2")
+	fs.BoolVar(&df.scores, "scores", false, "Also download each episode's showscores.php page, the scores after every clue")
+	fs.BoolVar(&df.players, "players", false, "Also download the player page of every contestant in the seasons, for players.csv")
+	fs.StringVar(&df.seasonTTL, "season-ttl", download.DefaultSeasonPageTTL.String(), "How long a season page cached in the archive is used before fetching it again (e.g., 6h, 1d; 0 to always fetch)")
+	fs.BoolVar(&df.force, "force-refresh", false, "Fetch season pages even when the cached copy is recent enough")
+	fs.Float64Var(&df.rate, "rate", download.DefaultRequestsPerMinute, "Requests per minute to J! Archive, shared by every season downloading at once")
+	return df
+}
+
+// merges in the config file and returns the seasons and options for download.Run
+func (df *downloadFlags) options(e *env) ([]string, download.Options, error) {
+	if e.fromConfig("seasons") && len(e.cfg.Seasons) > 0 {
+		df.seasons = strings.Join(e.cfg.Seasons, ",")
+	}
+	if e.fromConfig("refresh") && e.cfg.Refresh != nil {
+		df.refresh = *e.cfg.Refresh
+	}
+	if e.fromConfig("older-than") && e.cfg.OlderThan != "" {
+		df.olderThan = e.cfg.OlderThan
+	}
+	if e.fromConfig("scores") && e.cfg.Scores != nil {
+		df.scores = *e.cfg.Scores
+	}
+	if e.fromConfig("players") && e.cfg.Players != nil {
+		df.players = *e.cfg.Players
+	}
+	if e.fromConfig("season-ttl") && e.cfg.SeasonTTL != "" {
+		df.seasonTTL = e.cfg.SeasonTTL
+	}
+	if e.fromConfig("no-http2") && e.cfg.NoHTTP2 != nil {
+		df.noHTTP2 = *e.cfg.NoHTTP2
+	}
+	if e.fromConfig("rate") && e.cfg.Rate != 0 {
+		df.rate = e.cfg.Rate
+	}
+	if e.fromConfig("base-url") && e.cfg.BaseURL != "" {
+		df.baseURL = e.cfg.BaseURL
+	}
+	if u, err := url.Parse(df.baseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, download.Options{}, fmt.Errorf("invalid -base-url %q: want an http or https URL", df.baseURL)
+	}
+	if df.rate <= 0 {
+		return nil, download.Options{}, errors.New("-rate must be positive")
+	}
+
+	opts := download.Options{
+		Refresh:           df.refresh,
+		BaseURL:           df.baseURL,
+		DisableHTTP2:      df.noHTTP2,
+		NoProgress:        e.common.noProgress,
+		ArchiveDir:        e.common.archiveDir,
+		Concurrency:       e.cfg.Concurrency,
+		RequestsPerMinute: df.rate,
+		MinDelay:          e.cfg.Delay.Min,
+		MaxDelay:          e.cfg.Delay.Max,
+		ForceRefresh:      df.force,
+		Scores:            df.scores,
+		Players:           df.players,
+	}
+	ttl, err := download.ParseAge(df.seasonTTL)
+	if err != nil {
+		return nil, opts, fmt.Errorf("invalid -season-ttl value: %v", err)
+	}
+	opts.SeasonPageTTL = ttl
+	if ttl == 0 {
+		opts.SeasonPageTTL = -1
+	}
+	if df.olderThan != "" {
+		age, err := download.ParseAge(df.olderThan)
+		if err != nil {
+			return nil, opts, fmt.Errorf("invalid -older-than value: %v", err)
+		}
+		opts.OlderThan = age
+	}
+
+	seasons := []string{}
+	if strings.TrimSpace(df.seasons) == "all" {
+		list := download.New(opts).ListSeasons
+		if e.common.dryRun {
+			// a dry run writes nothing, the cached season list included
+			list = download.New(opts).PlanSeasons
+		}
+		all, err := list()
+		if err != nil {
+			return nil, opts, fmt.Errorf("error fetching season list: %v", err)
+		}
+		seasons = all
+	} else if df.seasons != "" {
+		list, err := splitSeasons(df.seasons)
+		if err != nil {
+			return nil, opts, err
+		}
+		seasons = list
+	}
+	return seasons, opts, nil
+}
+
+// splits a comma-separated season list, checking each identifier
+func splitSeasons(list string) ([]string, error) {
+	var seasons []string
+	for _, s := range strings.Split(list, ",") {
+		id := strings.TrimSpace(s)
+		if !download.ValidSeason(id) {
+			return nil, fmt.Errorf("invalid season: %q", s)
+		}
+		seasons = append(seasons, id)
+	}
+	return seasons, nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/stats"
+)
+
+var duplicatesCommand = &command{
+	name:    "duplicates",
+	summary: "List the clues whose text was already played in an earlier game, with the game it was first seen in.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season with a CSV)")
+		format := fs.String("format", "csv", "Output format: csv or json")
+		output := fs.String("o", "", "Write the report to this file instead of standard output")
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			var write func(io.Writer, []stats.Duplicate) error
+			switch *format {
+			case "csv":
+				write = stats.WriteDuplicatesCSV
+			case "json":
+				write = stats.WriteDuplicatesJSON
+			default:
+				return fmt.Errorf("unknown format %q (want csv or json)", *format)
+			}
+			opts := stats.Options{CSVDir: *csvDir}
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if opts.Seasons, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			dups, err := stats.Duplicates(opts)
+			if err != nil {
+				return err
+			}
+			return writeOutput(*output, func(w io.Writer) error { return write(w, dups) })
+		}
+	},
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/export"
+	"j-parser-go/parse"
+)
+
+var exportCommand = &command{
+	name:    "export",
+	summary: "Export the parsed CSVs to another format, such as an Arrow file.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season with a CSV)")
+		format := fs.String("format", "arrow", "Format to export: arrow (an Arrow IPC / Feather v2 file), duckdb (a DuckDB database, needs -o), mysql (a MySQL or MariaDB database, needs -dsn), mongo (a MongoDB collection, needs -dsn), redis (keys for trivia bots, needs -dsn), cloze (flashcards for spaced-repetition tools), quizlet (a Quizlet import file) or trivia (Open Trivia DB JSON)")
+		output := fs.String("o", "", "Write the export to this file instead of standard output")
+		dsn := fs.String("dsn", "", "Database to export to: for mysql user:password@tcp(host:3306)/database, for mongo a mongodb:// URI, for redis a redis:// URL")
+		collection := fs.String("collection", "games", "MongoDB collection to export the games to")
+		prefix := fs.String("prefix", "jarchive:", "Prefix of the Redis keys to export the clues to")
+		incorrect := fs.Int("incorrect-answers", 3, "Wrong answers each trivia question gets from the other responses in its category (0 for none)")
+		direction := fs.String("direction", export.ClueToResponse, "Which way quizlet cards go: clue-response (the clue is the term) or response-clue")
+		uf := registerUploadFlags(fs)
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			var selected []string
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if selected, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+
+			var write func([]dataset.Clue) error
+			switch *format {
+			case "arrow":
+				write = func(clues []dataset.Clue) error {
+					return writeOutput(*output, func(w io.Writer) error { return export.WriteArrow(w, clues) })
+				}
+			case "duckdb":
+				if *output == "" {
+					return errors.New("-format duckdb needs -o, the database file to write")
+				}
+				write = func(clues []dataset.Clue) error { return export.WriteDuckDB(*output, clues) }
+			case "mysql":
+				if *dsn == "" {
+					return errors.New("-format mysql needs -dsn, the database to write to")
+				}
+				write = func(clues []dataset.Clue) error { return export.WriteMySQL(*dsn, clues) }
+			case "mongo":
+				if *dsn == "" {
+					return errors.New("-format mongo needs -dsn, the database to write to")
+				}
+				// documents hold whole games, contestants included, which
+				// the CSVs don't have; so they come from the archive
+				games, err := parse.Games(exportParseOptions(e, selected))
+				if err != nil {
+					return err
+				}
+				return export.WriteMongo(*dsn, *collection, games)
+			case "redis":
+				if *dsn == "" {
+					return errors.New("-format redis needs -dsn, the database to write to")
+				}
+				write = func(clues []dataset.Clue) error { return export.WriteRedis(*dsn, *prefix, clues) }
+			case "cloze":
+				write = func(clues []dataset.Clue) error {
+					return writeOutput(*output, func(w io.Writer) error { return export.WriteCloze(w, clues) })
+				}
+			case "quizlet":
+				if *direction != export.ClueToResponse && *direction != export.ResponseToClue {
+					return fmt.Errorf("unknown -direction %q (want %s or %s)", *direction, export.ClueToResponse, export.ResponseToClue)
+				}
+				write = func(clues []dataset.Clue) error {
+					return writeOutput(*output, func(w io.Writer) error { return export.WriteQuizlet(w, clues, *direction) })
+				}
+			case "trivia":
+				write = func(clues []dataset.Clue) error {
+					return writeOutput(*output, func(w io.Writer) error { return export.WriteTrivia(w, clues, max(*incorrect, 0)) })
+				}
+			default:
+				return fmt.Errorf("unknown format %q (want arrow, duckdb, mysql, mongo, redis, cloze, quizlet or trivia)", *format)
+			}
+
+			clues, err := dataset.Load(dataset.Options{Dir: *csvDir, Seasons: selected})
+			if err != nil {
+				return err
+			}
+			if err := write(clues); err != nil {
+				return err
+			}
+			if *output != "" && (*format == "arrow" || *format == "duckdb" || *format == "cloze" || *format == "quizlet" || *format == "trivia") {
+				return uf.uploadFile(e, *output)
+			}
+			return nil
+		}
+	},
+}
+
+// returns the options for parsing the archive's seasons as parse would,
+// with the config file's parse settings
+func exportParseOptions(e *env, seasons []string) parse.Options {
+	opts := parse.Options{
+		NoProgress:  e.common.noProgress,
+		Quiet:       e.common.quiet,
+		ArchiveDir:  e.common.archiveDir,
+		Seasons:     seasons,
+		Concurrency: e.cfg.Concurrency,
+	}
+	if e.cfg.RawText != nil {
+		opts.RawText = *e.cfg.RawText
+	}
+	if e.cfg.Markdown != nil {
+		opts.Markdown = *e.cfg.Markdown
+	}
+	if e.cfg.Unrevealed != nil {
+		opts.Unrevealed = *e.cfg.Unrevealed
+	}
+	return opts
+}
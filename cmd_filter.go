@@ -0,0 +1,27 @@
+package main
+
+import (
+	"flag"
+
+	"j-parser-go/search"
+)
+
+var filterCommand = &command{
+	name:    "filter",
+	summary: "Write the clues of the parsed CSVs matching the given filters to a new file, as a CSV by default.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		qf := registerQueryFlags(fs, "select from")
+		qf.registerOutput(fs, "csv")
+		return func(e *env) error {
+			q, err := qf.query(e)
+			if err != nil {
+				return err
+			}
+			clues, err := qf.load(q)
+			if err != nil {
+				return err
+			}
+			return qf.write(search.Search(clues, q))
+		}
+	},
+}
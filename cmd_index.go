@@ -0,0 +1,55 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"j-parser-go/index"
+)
+
+var indexCommand = &command{
+	name:    "index",
+	summary: "Build or update a full-text index of the parsed CSVs for fast searches.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		indexPath := fs.String("index", "jarchive-index.db", "The index database to build or update")
+		rebuild := fs.Bool("rebuild", false, "Re-index every season, not just those whose CSV changed")
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			if e.fromConfig("index") && e.cfg.Index != "" {
+				*indexPath = e.cfg.Index
+			}
+			opts := index.Options{CSVDir: *csvDir, Path: *indexPath, Rebuild: *rebuild}
+			if e.common.dryRun {
+				res, err := index.PlanBuild(opts)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("would index: %s\n", seasonList(res.Indexed))
+				fmt.Printf("unchanged: %s\n", seasonList(res.Unchanged))
+				fmt.Printf("would remove: %s\n", seasonList(res.Removed))
+				return nil
+			}
+			res, err := index.Build(opts)
+			if err != nil {
+				return err
+			}
+			if !e.common.quiet {
+				fmt.Printf("indexed %d seasons, %d unchanged, %d removed; %d clues in %s\n",
+					len(res.Indexed), len(res.Unchanged), len(res.Removed), res.Clues, *indexPath)
+			}
+			return nil
+		}
+	},
+}
+
+// formats seasons for a one-line summary
+func seasonList(seasons []string) string {
+	if len(seasons) == 0 {
+		return "none"
+	}
+	return strings.Join(seasons, ", ")
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/export"
+)
+
+var mergeCommand = &command{
+	name:    "merge",
+	summary: "Merge season CSVs, JSON files of clues and directories of them into one dataset, each clue once.",
+	args:    "<file-or-dir>...",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		format := fs.String("format", "csv", "Output format: csv or json")
+		columns := fs.String("columns", "", "Comma-separated list of columns to write, in order (default: every CSV column)")
+		output := fs.String("o", "", "Write the merged clues to this file instead of standard output")
+		return func(e *env) error {
+			if len(e.args) == 0 {
+				return errors.New("merge needs the files or directories to merge")
+			}
+			if *format != "csv" && *format != "json" {
+				return fmt.Errorf("unknown format %q (want csv or json)", *format)
+			}
+			if err := dataset.CheckColumns(splitList(*columns)); err != nil {
+				return fmt.Errorf("invalid -columns: %v", err)
+			}
+			var sets [][]dataset.Clue
+			for _, path := range e.args {
+				clues, err := readClues(path, nil)
+				if err != nil {
+					return err
+				}
+				sets = append(sets, clues)
+			}
+			merged := dataset.Merge(sets...)
+			names := outputColumns(splitList(*columns), merged)
+			write, err := clueWriter(*format, names)
+			if err != nil {
+				return err
+			}
+			return writeOutput(*output, func(w io.Writer) error { return write(w, merged) })
+		}
+	},
+}
+
+// reads the clues of a directory of season CSVs, or of a season CSV, JSON,
+// Arrow or Parquet file, keeping those of the given seasons if any
+func readClues(path string, seasons []string) ([]dataset.Clue, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return dataset.Load(dataset.Options{Dir: path, Seasons: seasons})
+	}
+	var clues []dataset.Clue
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".arrow", ".feather", ".parquet":
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if strings.EqualFold(filepath.Ext(path), ".parquet") {
+			clues, err = export.ReadParquet(f)
+		} else {
+			clues, err = export.ReadArrow(f)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %v", path, err)
+		}
+	default:
+		if clues, err = dataset.ReadFile(path); err != nil {
+			return nil, err
+		}
+	}
+	if len(seasons) > 0 {
+		clues = slices.DeleteFunc(clues, func(c dataset.Clue) bool { return !slices.Contains(seasons, c.Season) })
+	}
+	return clues, nil
+}
+
+// returns the columns to write clues with: those asked for, or when none
+// were the CSV columns, plus revealed if some clues are unrevealed so
+// their placeholders stay apart from the real clues
+func outputColumns(names []string, clues []dataset.Clue) []string {
+	if len(names) == 0 && slices.ContainsFunc(clues, func(c dataset.Clue) bool { return !c.Revealed }) {
+		return append(dataset.Header[:len(dataset.Header):len(dataset.Header)], "revealed")
+	}
+	return names
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+var parseCommand = &command{
+	name:    "parse",
+	summary: "Parse downloaded episodes into one CSV per season.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		pf := registerParseFlags(fs)
+		uf := registerUploadFlags(fs)
+		nf := registerNotifyFlags(fs)
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to parse (default: every season in the archive)")
+		skipSeasons := fs.String("skip-seasons", "", "Comma-separated list of seasons not to parse")
+		layout := fs.String("layout", parse.LayoutFlat, "Output layout: flat (one CSV per season) or normalized (games, categories, clues and contestants tables)")
+		target := fs.String("target", parse.TargetGames, "What to parse: games (the episodes), seasons (the season pages download leaves in the archive, into seasons.csv), scores or players (the pages download -scores or -players saves, into scores.csv or players.csv)")
+		watch := fs.Bool("watch", false, "Keep running and re-parse seasons as episodes are added to the archive (implies -incremental)")
+		return func(e *env) error {
+			opts := pf.options(e)
+			if e.fromConfig("layout") && e.cfg.Layout != "" {
+				*layout = e.cfg.Layout
+			}
+			opts.Layout = *layout
+			opts.Target = *target
+			if *watch && opts.Target != parse.TargetGames {
+				return fmt.Errorf("-watch only works with -target=%s", parse.TargetGames)
+			}
+			if e.fromConfig("seasons") && len(e.cfg.Seasons) > 0 {
+				*seasons = strings.Join(e.cfg.Seasons, ",")
+			}
+			var err error
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				if opts.Seasons, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			if *skipSeasons != "" {
+				if opts.SkipSeasons, err = splitSeasons(*skipSeasons); err != nil {
+					return err
+				}
+			}
+			if e.common.dryRun {
+				plan, err := parse.PlanRun(opts)
+				if err != nil {
+					return err
+				}
+				plan.Write(os.Stdout)
+				return nil
+			}
+			finish := func(res parse.Result, err error) error {
+				if errors.Is(err, context.Canceled) {
+					err = fmt.Errorf("interrupted: %w", err)
+				}
+				if err == nil {
+					err = pf.check(res)
+				}
+				if err == nil {
+					err = uf.uploadDir(e, opts.OutDir)
+				}
+				nf.send(e, "parse", err, nil, &res)
+				return err
+			}
+			if *watch {
+				ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+				defer stop()
+				// a failed run is reported and the next change tries again
+				return parse.Watch(ctx, opts, func(res parse.Result, err error) {
+					if err := finish(res, err); err != nil {
+						slog.Error("parse failed", "err", err)
+					}
+				})
+			}
+			// the first interrupt stops the run once the episodes under way
+			// are written; a second one stops it straight away
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				stop()
+			}()
+			return finish(parse.RunContext(ctx, opts))
+		}
+	},
+}
+
+// parseFlags are shared by the commands that parse episodes
+type parseFlags struct {
+	outDir                string
+	maxErrors             int
+	rawText               bool
+	markdown              bool
+	unrevealed            bool
+	valueNormalized       bool
+	valueNormalizedCutoff string
+	valueNormalizedFactor int
+	valueAdjusted         bool
+	difficulty            bool
+	dateFields            bool
+	bom                   bool
+	crlf                  bool
+	newlines              string
+	incremental           bool
+}
+
+func registerParseFlags(fs *flag.FlagSet) *parseFlags {
+	pf := &parseFlags{}
+	fs.StringVar(&pf.outDir, "out-dir", "parsed-csv", "Directory the CSVs and error report are written to")
+	fs.IntVar(&pf.maxErrors, "max-errors", -1, "Exit with an error if more than this many episodes fail to parse (-1 for no limit)")
+	fs.BoolVar(&pf.rawText, "raw-text", false, "Write clue text as found on the page, without normalizing entities, quotes and whitespace")
+	fs.BoolVar(&pf.markdown, "markdown", false, "Keep italics, bold and links in clues and responses as Markdown")
+	fs.BoolVar(&pf.incremental, "incremental", false, "Only re-parse episodes that are new or changed since the last incremental run")
+	fs.BoolVar(&pf.unrevealed, "unrevealed", false, "Write a placeholder row for every unrevealed clue and add a revealed column")
+	fs.BoolVar(&pf.valueNormalized, "value-normalized", false, "Add a value_normalized column with each clue's board value scaled to the post-doubling scale, doubling values from games before 2001-11-26")
+	fs.StringVar(&pf.valueNormalizedCutoff, "value-normalized-cutoff", jarchive.DoubledValuesDate, "With -value-normalized or -difficulty, the air date (YYYY-MM-DD) before which values are multiplied")
+	fs.IntVar(&pf.valueNormalizedFactor, "value-normalized-factor", jarchive.DoubledValuesFactor, "With -value-normalized or -difficulty, what values from games before the cutoff are multiplied by (a positive number)")
+	fs.BoolVar(&pf.valueAdjusted, "value-adjusted", false, "Add a value_adjusted column with each clue's value adjusted for inflation by the bundled CPI table")
+	fs.BoolVar(&pf.difficulty, "difficulty", false, "Add a difficulty column grading each clue from 0 (easiest) to 1 (hardest)")
+	fs.BoolVar(&pf.dateFields, "date-fields", false, "Add air_year, air_weekday and season_week columns derived from the air date")
+	fs.BoolVar(&pf.bom, "bom", false, "Start every CSV with a UTF-8 byte order mark, so Excel reads accented characters correctly")
+	fs.BoolVar(&pf.crlf, "crlf", false, "End CSV lines with CRLF, as Windows programs expect")
+	fs.StringVar(&pf.newlines, "newlines", parse.NewlinesSpace, "Line breaks in categories, clues and responses: space, preserve (in quoted fields) or escape (as \\n)")
+	return pf
+}
+
+// turns too many parse failures into an error, and so exitEpisodesFailed
+func (pf *parseFlags) check(res parse.Result) error {
+	if pf.maxErrors >= 0 && res.Failed > pf.maxErrors {
+		return &episodesFailedError{failed: res.Failed, max: pf.maxErrors}
+	}
+	return nil
+}
+
+// episodesFailedError is returned by a run that completed but had more
+// parse failures than -max-errors allows
+type episodesFailedError struct {
+	failed, max int
+}
+
+func (e *episodesFailedError) Error() string {
+	return fmt.Sprintf("%d episodes failed to parse, more than -max-errors=%d", e.failed, e.max)
+}
+
+// merges in the config file and builds parse.Options
+func (pf *parseFlags) options(e *env) parse.Options {
+	if e.fromConfig("out-dir") && e.cfg.OutDir != "" {
+		pf.outDir = e.cfg.OutDir
+	}
+	if e.fromConfig("raw-text") && e.cfg.RawText != nil {
+		pf.rawText = *e.cfg.RawText
+	}
+	if e.fromConfig("markdown") && e.cfg.Markdown != nil {
+		pf.markdown = *e.cfg.Markdown
+	}
+	if e.fromConfig("unrevealed") && e.cfg.Unrevealed != nil {
+		pf.unrevealed = *e.cfg.Unrevealed
+	}
+	if e.fromConfig("value-normalized") && e.cfg.ValueNormalized != nil {
+		pf.valueNormalized = *e.cfg.ValueNormalized
+	}
+	if e.fromConfig("value-normalized-cutoff") && e.cfg.ValueNormalizedCutoff != "" {
+		pf.valueNormalizedCutoff = e.cfg.ValueNormalizedCutoff
+	}
+	if e.fromConfig("value-normalized-factor") && e.cfg.ValueNormalizedFactor != nil {
+		pf.valueNormalizedFactor = *e.cfg.ValueNormalizedFactor
+	}
+	if e.fromConfig("value-adjusted") && e.cfg.ValueAdjusted != nil {
+		pf.valueAdjusted = *e.cfg.ValueAdjusted
+	}
+	if e.fromConfig("difficulty") && e.cfg.Difficulty != nil {
+		pf.difficulty = *e.cfg.Difficulty
+	}
+	if e.fromConfig("date-fields") && e.cfg.DateFields != nil {
+		pf.dateFields = *e.cfg.DateFields
+	}
+	if e.fromConfig("bom") && e.cfg.BOM != nil {
+		pf.bom = *e.cfg.BOM
+	}
+	if e.fromConfig("crlf") && e.cfg.CRLF != nil {
+		pf.crlf = *e.cfg.CRLF
+	}
+	if e.fromConfig("newlines") && e.cfg.Newlines != "" {
+		pf.newlines = e.cfg.Newlines
+	}
+	if e.fromConfig("incremental") && e.cfg.Incremental != nil {
+		pf.incremental = *e.cfg.Incremental
+	}
+	return parse.Options{
+		NoProgress:            e.common.noProgress,
+		Quiet:                 e.common.quiet,
+		ArchiveDir:            e.common.archiveDir,
+		OutDir:                pf.outDir,
+		Concurrency:           e.cfg.Concurrency,
+		RawText:               pf.rawText,
+		Markdown:              pf.markdown,
+		Unrevealed:            pf.unrevealed,
+		ValueNormalized:       pf.valueNormalized,
+		ValueNormalizedCutoff: pf.valueNormalizedCutoff,
+		ValueNormalizedFactor: &pf.valueNormalizedFactor,
+		ValueAdjusted:         pf.valueAdjusted,
+		Difficulty:            pf.difficulty,
+		DateFields:            pf.dateFields,
+		BOM:                   pf.bom,
+		CRLF:                  pf.crlf,
+		Newlines:              pf.newlines,
+		Incremental:           pf.incremental,
+	}
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"math/rand/v2"
+	"os"
+
+	"j-parser-go/dataset"
+	"j-parser-go/quiz"
+	"j-parser-go/search"
+)
+
+var playCommand = &command{
+	name:    "play",
+	summary: "Quiz yourself in the terminal on a whole game or on random clues, keeping score.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		qf := registerQueryFlags(fs, "play from")
+		game := fs.String("game", "", "Play this game, by show number, in board order (use -seasons to pick one when a number was used in more than one season)")
+		count := fs.Int("count", 10, "Without -game, the number of random clues to play")
+		seed := fs.Uint64("seed", 0, "Without -game, seed for the random picks, to get the same clues again (default: a new seed every run)")
+		return func(e *env) error {
+			q, err := qf.query(e)
+			if err != nil {
+				return err
+			}
+			clues, err := qf.load(q)
+			if err != nil {
+				return err
+			}
+
+			var picked []dataset.Clue
+			if *game != "" {
+				picked = gameClues(search.Search(clues, q), *game)
+				if len(picked) == 0 {
+					return fmt.Errorf("no clues from game %s match", *game)
+				}
+				quiz.GameOrder(picked)
+			} else {
+				s := *seed
+				if s == 0 {
+					s = rand.Uint64()
+				}
+				picked = search.Random(clues, q, *count, rand.New(rand.NewPCG(s, s)))
+				if len(picked) == 0 {
+					return errors.New("no clues match")
+				}
+			}
+			_, err = quiz.Play(picked, os.Stdin, os.Stdout)
+			return err
+		}
+	},
+}
+
+// returns the clues from the first season's game with the show number
+func gameClues(clues []dataset.Clue, epNum string) []dataset.Clue {
+	var game []dataset.Clue
+	for _, c := range clues {
+		if c.EpisodeNumber != epNum {
+			continue
+		}
+		if len(game) > 0 && c.Season != game[0].Season {
+			break
+		}
+		game = append(game, c)
+	}
+	return game
+}
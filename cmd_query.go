@@ -0,0 +1,63 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"j-parser-go/query"
+)
+
+var queryCommand = &command{
+	name:    "query",
+	summary: "Run SQL over the parsed clues in an embedded SQLite database.",
+	args:    "<sql>",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		in := fs.String("in", "parsed-csv", "Directory of season CSVs written by parse, or a season CSV, JSON, Arrow or Parquet file, to query")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to load (default: every season in the input)")
+		format := fs.String("format", "table", "Output format: table, csv or json")
+		output := fs.String("o", "", "Write the result to this file instead of standard output")
+		return func(e *env) error {
+			if e.fromConfig("in") && e.cfg.OutDir != "" {
+				*in = e.cfg.OutDir
+			}
+			stmt := strings.TrimSpace(strings.Join(e.args, " "))
+			if stmt == "" {
+				return fmt.Errorf("query needs the SQL to run, e.g. %q", "SELECT count(*) FROM clues")
+			}
+			if *format != "table" && *format != "csv" && *format != "json" {
+				return fmt.Errorf("unknown format %q (want table, csv or json)", *format)
+			}
+			var selected []string
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if selected, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			clues, err := readClues(*in, selected)
+			if err != nil {
+				return err
+			}
+			db, err := query.Open(clues)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			res, err := db.Query(stmt)
+			if err != nil {
+				return err
+			}
+			return writeOutput(*output, func(w io.Writer) error {
+				switch *format {
+				case "csv":
+					return res.WriteCSV(w)
+				case "json":
+					return res.WriteJSON(w)
+				}
+				return res.WriteTable(w)
+			})
+		}
+	},
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"flag"
+	"math/rand/v2"
+
+	"j-parser-go/search"
+)
+
+var randomCommand = &command{
+	name:    "random",
+	summary: "Print random clues from the parsed CSVs, optionally filtered by season, round, value or category.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		qf := registerQueryFlags(fs, "pick from")
+		qf.registerOutput(fs, "text")
+		count := fs.Int("count", 1, "Number of clues to pick")
+		seed := fs.Uint64("seed", 0, "Seed for the random picks, to get the same clues again (default: a new seed every run)")
+		return func(e *env) error {
+			q, err := qf.query(e)
+			if err != nil {
+				return err
+			}
+			clues, err := qf.load(q)
+			if err != nil {
+				return err
+			}
+			s := *seed
+			if s == 0 {
+				s = rand.Uint64()
+			}
+			return qf.write(search.Random(clues, q, *count, rand.New(rand.NewPCG(s, s))))
+		}
+	},
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"flag"
+	"math/rand/v2"
+
+	"j-parser-go/search"
+)
+
+var sampleCommand = &command{
+	name:    "sample",
+	summary: "Write a reproducible random subset of the parsed clues, optionally stratified by round, value or season, for test fixtures and demo datasets.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		qf := registerQueryFlags(fs, "sample")
+		qf.registerOutput(fs, "csv")
+		n := fs.Int("n", 100, "Number of clues in the sample")
+		seed := fs.Uint64("seed", 1, "Seed for the sample: the same seed, filters and CSVs always give the same clues")
+		stratify := fs.String("stratify", "", "Comma-separated list of round, value and season: give each combination its share of the sample")
+		return func(e *env) error {
+			q, err := qf.query(e)
+			if err != nil {
+				return err
+			}
+			clues, err := qf.load(q)
+			if err != nil {
+				return err
+			}
+			sample, err := search.Sample(clues, q, *n, splitList(*stratify), rand.New(rand.NewPCG(*seed, *seed)))
+			if err != nil {
+				return err
+			}
+			return qf.write(sample)
+		}
+	},
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"flag"
+	"io"
+
+	"j-parser-go/validate"
+)
+
+var schemaCommand = &command{
+	name:    "schema",
+	summary: "Print the JSON Schema of a row of the season CSVs.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		output := fs.String("o", "", "Write the schema to this file instead of standard output")
+		return func(e *env) error {
+			return writeOutput(*output, func(w io.Writer) error {
+				_, err := w.Write(validate.Schema)
+				return err
+			})
+		}
+	},
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/index"
+	"j-parser-go/search"
+)
+
+var searchCommand = &command{
+	name:    "search",
+	args:    "<words>",
+	summary: "Search the clues, responses and categories in the parsed CSVs.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		qf := registerQueryFlags(fs, "search")
+		qf.registerOutput(fs, "text")
+		fields := fs.String("fields", "", "Comma-separated list of fields to search: question, answer, category (default: all three)")
+		limit := fs.Int("limit", 0, "Stop after this many matches (0 for no limit)")
+		useIndex := fs.Bool("use-index", false, "Search the index built by jarchive index instead of reading the CSVs")
+		indexPath := fs.String("index", "jarchive-index.db", "With -use-index, the index database to search")
+		return func(e *env) error {
+			if e.fromConfig("index") && e.cfg.Index != "" {
+				*indexPath = e.cfg.Index
+			}
+			q, err := qf.query(e)
+			if err != nil {
+				return err
+			}
+			q.Text = strings.Join(e.args, " ")
+			q.Fields = splitList(*fields)
+			q.Limit = *limit
+			if q.Text == "" && q.Category == "" && q.CategoryRegex == "" && len(q.Rounds) == 0 && q.MinValue == 0 && q.MaxValue == 0 &&
+				q.After == "" && q.Before == "" && !q.DailyDouble && !q.TripleStumper {
+				return errors.New("nothing to search for: give some words or a -category, -category-regex, -round, -value, -after, -before, -daily-double or -triple-stumper filter")
+			}
+			if err := q.Validate(); err != nil {
+				return err
+			}
+
+			var matches []dataset.Clue
+			if *useIndex {
+				ix, err := index.Open(*indexPath)
+				if err != nil {
+					return err
+				}
+				defer ix.Close()
+				if matches, err = ix.Search(q); err != nil {
+					return err
+				}
+			} else {
+				clues, err := qf.load(q)
+				if err != nil {
+					return err
+				}
+				matches = search.Search(clues, q)
+			}
+			return qf.write(matches)
+		}
+	},
+}
+
+// queryFlags are the filters and output flags shared by the commands that
+// pick clues from the parsed CSVs
+type queryFlags struct {
+	csvDir        string
+	seasons       string
+	rounds        string
+	category      string
+	categoryRegex string
+	value         string
+	minValue      int
+	maxValue      int
+	after         string
+	before        string
+	dailyDouble   bool
+	tripleStumper bool
+	format        string
+	columns       string
+	output        string
+}
+
+// registers the filter flags; verb completes their help text ("search",
+// "pick from")
+func registerQueryFlags(fs *flag.FlagSet, verb string) *queryFlags {
+	qf := &queryFlags{}
+	fs.StringVar(&qf.csvDir, "csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+	fs.StringVar(&qf.seasons, "seasons", "", "Comma-separated list of seasons to "+verb+" (default: every season with a CSV)")
+	fs.StringVar(&qf.rounds, "round", "", "Comma-separated list of rounds to "+verb+": J, DJ, TJ, FJ or TB (default: every round)")
+	fs.StringVar(&qf.category, "category", "", "Only clues from the category with this name, ignoring case")
+	fs.StringVar(&qf.categoryRegex, "category-regex", "", "Only clues from categories matching this regular expression, e.g. (?i)^potent")
+	fs.StringVar(&qf.value, "value", "", "Only clues with this value: 1600, >=1600, <=800 or 800-1600")
+	fs.IntVar(&qf.minValue, "min-value", 0, "Only clues worth at least this many dollars")
+	fs.IntVar(&qf.maxValue, "max-value", 0, "Only clues worth at most this many dollars")
+	fs.StringVar(&qf.after, "after", "", "Only clues aired on or after this date, YYYY-MM-DD")
+	fs.StringVar(&qf.before, "before", "", "Only clues aired on or before this date, YYYY-MM-DD")
+	fs.BoolVar(&qf.dailyDouble, "daily-double", false, "Only Daily Doubles")
+	fs.BoolVar(&qf.tripleStumper, "triple-stumper", false, "Only clues nobody got right")
+	return qf
+}
+
+// registers -format, with the given default, -columns and -o for the
+// commands that print the clues they pick
+func (qf *queryFlags) registerOutput(fs *flag.FlagSet, format string) {
+	fs.StringVar(&qf.format, "format", format, "Output format: text, csv or json")
+	fs.StringVar(&qf.columns, "columns", "", "With -format csv or json, comma-separated list of the columns to write, in order, e.g. epNum,airDate,category,question,answer (default: every column)")
+	fs.StringVar(&qf.output, "o", "", "Write the clues to this file instead of standard output")
+}
+
+// merges in the config file and builds the query the filters describe
+func (qf *queryFlags) query(e *env) (search.Query, error) {
+	if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+		qf.csvDir = e.cfg.OutDir
+	}
+	q := search.Query{
+		Rounds:        splitList(qf.rounds),
+		Category:      qf.category,
+		CategoryRegex: qf.categoryRegex,
+		MinValue:      qf.minValue,
+		MaxValue:      qf.maxValue,
+		After:         qf.after,
+		Before:        qf.before,
+		DailyDouble:   qf.dailyDouble,
+		TripleStumper: qf.tripleStumper,
+	}
+	if qf.value != "" {
+		lo, hi, err := search.ParseValueRange(qf.value)
+		if err != nil {
+			return q, fmt.Errorf("invalid -value: %v", err)
+		}
+		q.MinValue, q.MaxValue = lo, hi
+	}
+	if qf.format != "" {
+		if _, err := clueWriter(qf.format, splitList(qf.columns)); err != nil {
+			return q, err
+		}
+	}
+	if qf.seasons != "" && strings.TrimSpace(qf.seasons) != "all" {
+		var err error
+		if q.Seasons, err = splitSeasons(qf.seasons); err != nil {
+			return q, err
+		}
+	}
+	return q, q.Validate()
+}
+
+// reads the CSVs of the seasons q is limited to
+func (qf *queryFlags) load(q search.Query) ([]dataset.Clue, error) {
+	return dataset.Load(dataset.Options{Dir: qf.csvDir, Seasons: q.Seasons})
+}
+
+// writes clues in the chosen -format to -o or standard output
+func (qf *queryFlags) write(clues []dataset.Clue) error {
+	write, err := clueWriter(qf.format, splitList(qf.columns))
+	if err != nil {
+		return err
+	}
+	return writeOutput(qf.output, func(w io.Writer) error { return write(w, clues) })
+}
+
+// returns the function writing clues in the given -format, with only the
+// given -columns if there are any
+func clueWriter(format string, columns []string) (func(io.Writer, []dataset.Clue) error, error) {
+	if err := dataset.CheckColumns(columns); err != nil {
+		return nil, fmt.Errorf("invalid -columns: %v", err)
+	}
+	switch format {
+	case "text":
+		if len(columns) > 0 {
+			return nil, errors.New("-columns needs -format csv or json")
+		}
+		return search.WriteText, nil
+	case "csv":
+		return func(w io.Writer, clues []dataset.Clue) error { return dataset.WriteCSVColumns(w, clues, columns) }, nil
+	case "json":
+		return func(w io.Writer, clues []dataset.Clue) error { return dataset.WriteJSONColumns(w, clues, columns) }, nil
+	}
+	return nil, fmt.Errorf("unknown format %q (want text, csv or json)", format)
+}
+
+// runs write against the named file, or standard output if path is empty
+func writeOutput(path string, write func(io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("error writing %s: %v", path, err)
+	}
+	return f.Close()
+}
+
+// splits a comma-separated list, dropping blanks
+func splitList(list string) []string {
+	var items []string
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			items = append(items, s)
+		}
+	}
+	return items
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"j-parser-go/dataset"
+	"j-parser-go/metrics"
+	"j-parser-go/server"
+)
+
+var serveCommand = &command{
+	name:    "serve",
+	summary: "Serve the parsed CSVs as a JSON HTTP API and a GraphQL endpoint.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		addr := fs.String("addr", "localhost:8080", "Address to listen on")
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			if e.fromConfig("addr") && e.cfg.Addr != "" {
+				*addr = e.cfg.Addr
+			}
+			clues, err := dataset.Load(dataset.Options{Dir: *csvDir})
+			if err != nil {
+				return err
+			}
+			srv, err := server.New(clues, server.Options{ArchiveDir: e.common.archiveDir})
+			if err != nil {
+				return err
+			}
+			mux := http.NewServeMux()
+			mux.Handle("GET /metrics", metrics.Handler())
+			mux.Handle("/", metrics.Served(srv))
+			fmt.Fprintf(os.Stderr, "serving %d clues on http://%s\n", len(clues), *addr)
+			return http.ListenAndServe(*addr, mux)
+		}
+	},
+}
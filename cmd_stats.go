@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"j-parser-go/stats"
+)
+
+var statsCommand = &command{
+	name:    "stats",
+	summary: "Compute summary statistics per season and era, and where Daily Doubles are found, from the parsed CSVs.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		csvDir := fs.String("csv-dir", "parsed-csv", "Directory holding the season CSVs written by parse")
+		statsDir := fs.String("stats-dir", "stats", "Directory the statistics are written to")
+		seasons := fs.String("seasons", "", "Comma-separated list of seasons to include (default: every season with a CSV)")
+		uf := registerUploadFlags(fs)
+		return func(e *env) error {
+			if e.fromConfig("csv-dir") && e.cfg.OutDir != "" {
+				*csvDir = e.cfg.OutDir
+			}
+			if e.fromConfig("stats-dir") && e.cfg.StatsDir != "" {
+				*statsDir = e.cfg.StatsDir
+			}
+			opts := stats.Options{CSVDir: *csvDir, OutDir: *statsDir}
+			if *seasons != "" && strings.TrimSpace(*seasons) != "all" {
+				var err error
+				if opts.Seasons, err = splitSeasons(*seasons); err != nil {
+					return err
+				}
+			}
+			if e.common.dryRun {
+				list, err := stats.Seasons(opts)
+				if err != nil {
+					return err
+				}
+				fmt.Printf("would read %d season CSVs from %s\n", len(list), *csvDir)
+				for _, file := range stats.Files(opts) {
+					fmt.Printf("would write %s\n", file)
+				}
+				return nil
+			}
+			if _, err := stats.Run(opts); err != nil {
+				return err
+			}
+			return uf.uploadDir(e, *statsDir)
+		}
+	},
+}
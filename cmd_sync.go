@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"j-parser-go/download"
+	"j-parser-go/parse"
+)
+
+var syncCommand = &command{
+	name:    "sync",
+	summary: "Download the given seasons and parse new episodes as they arrive, rewriting each season's CSV when its download finishes.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		df := registerDownloadFlags(fs)
+		pf := registerParseFlags(fs)
+		uf := registerUploadFlags(fs)
+		nf := registerNotifyFlags(fs)
+		noStore := fs.Bool("no-store", false, "Parse pages straight from the network without saving the HTML; every listed episode is fetched")
+		return func(e *env) error {
+			seasons, opts, err := df.options(e)
+			if err != nil {
+				return err
+			}
+			if e.common.dryRun {
+				return syncPlan(seasons, opts, pf.options(e), *noStore)
+			}
+			ctx, stop := interruptContext()
+			defer stop()
+			return runSync(ctx, e, "sync", seasons, opts, pf.options(e), pf, uf, nf, *noStore)
+		}
+	},
+}
+
+// downloads the seasons, parsing episodes as they arrive, then uploads the
+// CSVs and sends the notification if the flags ask for them. Once ctx is
+// done the download stops between episodes and the seasons being written
+// stop as in an interrupted parse.
+func runSync(ctx context.Context, e *env, command string, seasons []string, opts download.Options, parseOpts parse.Options,
+	pf *parseFlags, uf *uploadFlags, nf *notifyFlags, noStore bool) error {
+	var syncer *parse.Syncer
+	var err error
+	if noStore {
+		syncer, err = parse.NewStreamingSyncerContext(ctx, parseOpts)
+		if err != nil {
+			return err
+		}
+		opts.OnBody = syncer.AddBody
+	} else {
+		syncer, err = parse.NewSyncerContext(ctx, parseOpts)
+		if err != nil {
+			return err
+		}
+		opts.OnSaved = syncer.Add
+	}
+	opts.OnSeasonDone = syncer.SeasonDone
+	downloaded, err := download.RunContext(ctx, seasons, opts)
+	res := syncer.Close()
+	err = downloadInterrupted(downloaded, err)
+	if err == nil {
+		err = pf.check(res)
+	}
+	if err == nil {
+		err = uf.uploadDir(e, parseOpts.OutDir)
+	}
+	nf.send(e, command, err, &downloaded, &res)
+	return err
+}
+
+// prints the download plan and the CSVs that would be rewritten. With
+// noStore every listed episode counts as wanted, as in a real run.
+func syncPlan(seasons []string, opts download.Options, parseOpts parse.Options, noStore bool) error {
+	if noStore {
+		opts.OnBody = func(season, episode string, body []byte) {}
+	}
+	plan, err := download.New(opts).Plan(seasons)
+	if err != nil {
+		return err
+	}
+	plan.Write(os.Stdout)
+	for _, s := range plan.Seasons {
+		if s.Err == nil {
+			fmt.Printf("would rewrite %s\n", parse.CSVPath(parseOpts, s.Season))
+		}
+	}
+	return nil
+}
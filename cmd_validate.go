@@ -0,0 +1,41 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"j-parser-go/validate"
+)
+
+var validateCommand = &command{
+	name:    "validate",
+	summary: "Check the season CSVs, or a JSON file of rows, against the JSON Schema schema prints.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		in := fs.String("in", "parsed-csv", "Directory of season CSVs, or a single season CSV or JSON file, to check")
+		return func(e *env) error {
+			if e.fromConfig("in") && e.cfg.OutDir != "" {
+				*in = e.cfg.OutDir
+			}
+			rep, err := validate.Run(validate.Options{In: *in})
+			if err != nil {
+				return err
+			}
+			rep.Write(os.Stdout)
+			if n := len(rep.Problems); n > 0 {
+				return &invalidError{problems: n, in: *in}
+			}
+			return nil
+		}
+	},
+}
+
+// invalidError is returned by a validate that found problems
+type invalidError struct {
+	problems int
+	in       string
+}
+
+func (e *invalidError) Error() string {
+	return fmt.Sprintf("%d problems found in %s", e.problems, e.in)
+}
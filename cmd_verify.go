@@ -0,0 +1,57 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"j-parser-go/download"
+)
+
+var verifyCommand = &command{
+	name:    "verify",
+	summary: "Check the archive against the season pages and report the episodes missing from it.",
+	setup: func(fs *flag.FlagSet) func(e *env) error {
+		df := registerDownloadFlags(fs)
+		fetch := fs.Bool("fetch", false, "Download the missing episodes, then report what is still missing")
+		return func(e *env) error {
+			seasons, opts, err := df.options(e)
+			if err != nil {
+				return err
+			}
+			d := download.New(opts)
+			audit, err := d.Verify(seasons)
+			if err != nil {
+				return err
+			}
+			audit.Write(os.Stdout)
+			if *fetch && audit.Missing() > 0 && !e.common.dryRun {
+				res, err := d.Run(audit.Incomplete())
+				if err != nil {
+					return err
+				}
+				fmt.Printf("fetched %d of %d missing episodes\n", res.Downloaded, audit.Missing())
+				if audit, err = d.Verify(audit.Incomplete()); err != nil {
+					return err
+				}
+			}
+			if n := audit.Missing(); n > 0 {
+				return &missingError{missing: n}
+			}
+			if n := audit.Failed(); n > 0 {
+				return fmt.Errorf("%d season pages couldn't be read", n)
+			}
+			return nil
+		}
+	},
+}
+
+// missingError is returned by a verify that found episodes missing from
+// the archive
+type missingError struct {
+	missing int
+}
+
+func (e *missingError) Error() string {
+	return fmt.Sprintf("%d episodes missing from the archive", e.missing)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"j-parser-go/internal/config"
+	"j-parser-go/internal/logging"
+	"j-parser-go/tracing"
+)
+
+// command is one jarchive subcommand with its own flag set
+type command struct {
+	name    string
+	summary string
+	// positional arguments shown in the usage line, e.g. "<query>"; commands
+	// without any reject positional arguments
+	args string
+	// registers the command's flags on fs and returns the function that runs
+	// the command once flags, config and logging have been set up
+	setup func(fs *flag.FlagSet) func(e *env) error
+}
+
+// env is what a command sees when it runs
+type env struct {
+	cfg    *config.Config
+	common *commonFlags
+	// names of the flags given explicitly on the command line or in the
+	// environment
+	set map[string]bool
+	// positional arguments left after the flags
+	args []string
+}
+
+// reports whether the config file should supply the value for the named
+// flag, i.e. the flag wasn't given on the command line or in the environment
+func (e *env) fromConfig(name string) bool {
+	return !e.set[name]
+}
+
+func (c *command) flagSet() (*flag.FlagSet, *commonFlags, func(*env) error) {
+	fs := flag.NewFlagSet(c.name, flag.ExitOnError)
+	run := c.setup(fs)
+	common := registerCommonFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: jarchive %s [flags]%s\n\n%s\n\nFlags:\n", c.name, c.argsUsage(), c.summary)
+		fs.PrintDefaults()
+		fmt.Fprintf(fs.Output(), "\nEvery flag can also be set with an environment variable, e.g. %s for -archive-dir.\n", config.EnvName("archive-dir"))
+	}
+	return fs, common, run
+}
+
+// returns the positional arguments for the usage line, with a leading space
+func (c *command) argsUsage() string {
+	if c.args == "" {
+		return ""
+	}
+	return " " + c.args
+}
+
+// prints the command's help text
+func (c *command) usage() {
+	fs, _, _ := c.flagSet()
+	fs.Usage()
+}
+
+func (c *command) run(args []string) error {
+	fs, common, run := c.flagSet()
+	fs.Parse(args)
+	var positional []string
+	if c.args != "" {
+		// flags may come after the positional arguments too
+		for fs.NArg() > 0 {
+			positional = append(positional, fs.Arg(0))
+			fs.Parse(fs.Args()[1:])
+		}
+	} else if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected arguments: %v", fs.Args())
+	}
+
+	set := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { set[f.Name] = true })
+	// before loading the config, so that JARCHIVE_CONFIG can name it
+	if err := setFromEnv(fs, set); err != nil {
+		return err
+	}
+	cfg, err := config.Load(common.configPath)
+	if err != nil {
+		return err
+	}
+	e := &env{cfg: cfg, common: common, set: set, args: positional}
+	if err := common.apply(e); err != nil {
+		return err
+	}
+	stopTracing, err := tracing.Setup(context.Background(), common.otlpEndpoint)
+	if err != nil {
+		return fmt.Errorf("error setting up tracing: %v", err)
+	}
+	stopProfiles, err := common.startProfiles()
+	if err != nil {
+		return errors.Join(err, stopTracing())
+	}
+	return errors.Join(run(e), stopProfiles(), stopTracing())
+}
+
+// sets the flags not given on the command line from their JARCHIVE_*
+// variables, adding them to set so that they win over the config file
+func setFromEnv(fs *flag.FlagSet, set map[string]bool) error {
+	var errs []error
+	fs.VisitAll(func(f *flag.Flag) {
+		name := config.EnvName(f.Name)
+		v, ok := config.LookupEnv(name)
+		if !ok || set[f.Name] {
+			return
+		}
+		if err := fs.Set(f.Name, v); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %q for %s: %v", v, name, err))
+			return
+		}
+		set[f.Name] = true
+	})
+	return errors.Join(errs...)
+}
+
+// commonFlags are accepted by every command
+type commonFlags struct {
+	configPath   string
+	archiveDir   string
+	dryRun       bool
+	noProgress   bool
+	logLevel     string
+	logFormat    string
+	quiet        bool
+	verbose      bool
+	veryVerbose  bool
+	cpuProfile   string
+	memProfile   string
+	otlpEndpoint string
+}
+
+func registerCommonFlags(fs *flag.FlagSet) *commonFlags {
+	c := &commonFlags{}
+	fs.StringVar(&c.configPath, "config", "", "Path to a YAML config file (default: "+config.DefaultFile+" if present)")
+	fs.StringVar(&c.archiveDir, "archive-dir", "season-archive", "Directory holding the downloaded season folders")
+	fs.BoolVar(&c.dryRun, "dry-run", false, "Print what would be downloaded or written without doing it")
+	fs.BoolVar(&c.noProgress, "no-progress", false, "Don't draw the progress display")
+	fs.StringVar(&c.logLevel, "log-level", "warn", "Log level: debug, info, warn or error")
+	fs.StringVar(&c.logFormat, "log-format", "text", "Log format: text or json")
+	fs.BoolVar(&c.quiet, "q", false, "Quiet: only print errors")
+	fs.BoolVar(&c.verbose, "v", false, "Verbose: log progress per season (same as -log-level=info)")
+	fs.BoolVar(&c.veryVerbose, "vv", false, "Very verbose: log every episode and clue (same as -log-level=debug)")
+	fs.StringVar(&c.cpuProfile, "cpuprofile", "", "Write a CPU profile of the command to this file, for go tool pprof")
+	fs.StringVar(&c.memProfile, "memprofile", "", "Write a heap profile to this file when the command finishes, for go tool pprof")
+	fs.StringVar(&c.otlpEndpoint, "otlp-endpoint", "", "Send traces of downloads and parses to this OTLP/HTTP endpoint, e.g. http://localhost:4318 (default: OTEL_EXPORTER_OTLP_ENDPOINT, if set)")
+	return c
+}
+
+// merges config file values into unset flags and installs the logger
+func (c *commonFlags) apply(e *env) error {
+	if e.fromConfig("archive-dir") && e.cfg.ArchiveDir != "" {
+		c.archiveDir = e.cfg.ArchiveDir
+	}
+	if e.fromConfig("no-progress") && e.cfg.NoProgress != nil {
+		c.noProgress = *e.cfg.NoProgress
+	}
+	if e.fromConfig("log-level") && e.cfg.LogLevel != "" {
+		c.logLevel = e.cfg.LogLevel
+	}
+	if e.fromConfig("log-format") && e.cfg.LogFormat != "" {
+		c.logFormat = e.cfg.LogFormat
+	}
+	if e.fromConfig("otlp-endpoint") && e.cfg.OTLPEndpoint != "" {
+		c.otlpEndpoint = e.cfg.OTLPEndpoint
+	}
+
+	// -q, -v and -vv are shorthands that take precedence over -log-level
+	switch {
+	case c.quiet:
+		c.logLevel = "error"
+		c.noProgress = true
+	case c.veryVerbose:
+		c.logLevel = "debug"
+	case c.verbose:
+		c.logLevel = "info"
+	}
+	return logging.Setup(c.logLevel, c.logFormat)
+}
@@ -0,0 +1,281 @@
+// Package dataset reads the season CSVs written by the parse package back
+// into clues, for the commands that work on the parsed archive rather than
+// the HTML.
+package dataset
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+var csvFolder = "parsed-csv"
+
+// Options controls which CSVs Load reads
+type Options struct {
+	// directory holding the season CSVs, "parsed-csv" if empty
+	Dir string
+	// only read these seasons; every season with a CSV if empty
+	Seasons []string
+}
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.Dir == "" {
+		o.Dir = csvFolder
+	}
+}
+
+// Clue is one CSV row: a clue and the game it was played in
+type Clue struct {
+	Season string
+	// J! Archive's game_id, empty if it isn't known
+	GameID        string
+	EpisodeNumber string
+	AirDate       string
+	// the game's jarchive.Tournament, empty for regular games
+	Tournament      string
+	TournamentStage string
+	TournamentGame  int
+	Host            string
+	// regular, celebrity or team, as in jarchive.Game
+	Format string
+	jarchive.Clue
+}
+
+// returns the clue's stable ID, the clue_id column
+func (c *Clue) ID() string {
+	return jarchive.ClueID(c.GameID, c.Season, c.EpisodeNumber, c.Clue)
+}
+
+// encodes the clue's fields with its ID first, leaving "&" and other HTML
+// characters as they are
+func (c Clue) MarshalJSON() ([]byte, error) {
+	type fields Clue
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(struct {
+		ID string
+		fields
+	}{c.ID(), fields(c)})
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), err
+}
+
+// returns the seasons Load would read, in season order
+func Seasons(opts Options) ([]string, error) {
+	opts.setDefaults()
+	if len(opts.Seasons) > 0 {
+		return opts.Seasons, nil
+	}
+	seasons, err := parse.ParsedSeasons(parse.Options{OutDir: opts.Dir})
+	if err != nil {
+		return nil, fmt.Errorf("error listing CSVs in %s: %v", opts.Dir, err)
+	}
+	return seasons, nil
+}
+
+// reads every selected season CSV, in season order and within a season in
+// the order the rows were written
+func Load(opts Options) ([]Clue, error) {
+	var clues []Clue
+	for c, err := range AllClues(opts) {
+		if err != nil {
+			return nil, err
+		}
+		clues = append(clues, c)
+	}
+	return clues, nil
+}
+
+// returns the clues of every selected season CSV in Load's order, reading
+// one row at a time so that only the clue being yielded is in memory. An
+// error is yielded with a zero Clue and ends the sequence.
+func AllClues(opts Options) iter.Seq2[Clue, error] {
+	return func(yield func(Clue, error) bool) {
+		opts.setDefaults()
+		seasons, err := Seasons(opts)
+		if err == nil && len(seasons) == 0 {
+			err = fmt.Errorf("no season CSVs in %s; run jarchive parse first", opts.Dir)
+		}
+		if err != nil {
+			yield(Clue{}, err)
+			return
+		}
+		newlines := ""
+		if schema, err := parse.ReadSchema(opts.Dir); err != nil {
+			slog.Warn("error reading schema", "dir", opts.Dir, "err", err)
+		} else if schema != nil {
+			if schema.SchemaVersion > parse.SchemaVersion {
+				slog.Warn("CSVs were written by a newer version; some columns may be missing or misread",
+					"dir", opts.Dir, "schemaVersion", schema.SchemaVersion, "supported", parse.SchemaVersion, "generator", schema.Generator)
+			}
+			newlines = schema.Newlines
+		}
+		for _, season := range seasons {
+			if !yieldSeason(opts.Dir, season, newlines, yield) {
+				return
+			}
+		}
+	}
+}
+
+// yields the clues of one season CSV in dir written with the newline mode
+// newlines, false once yield has asked to stop or an error was yielded
+func yieldSeason(dir, season, newlines string, yield func(Clue, error) bool) bool {
+	path := parse.CSVPath(parse.Options{OutDir: dir}, season)
+	f, err := os.Open(path)
+	if err != nil {
+		yield(Clue{}, err)
+		return false
+	}
+	defer f.Close()
+	rows, err := newReader(f, season, newlines)
+	n := 0
+	for err == nil {
+		var c Clue
+		if c, err = rows.next(); err == nil {
+			n++
+			if !yield(c, nil) {
+				return false
+			}
+		}
+	}
+	if err != io.EOF {
+		yield(Clue{}, fmt.Errorf("error reading %s: %v", path, err))
+		return false
+	}
+	slog.Debug("read season CSV", "season", season, "file", path, "clues", n)
+	return true
+}
+
+// reads one season CSV. Columns are found by name, so CSVs written with
+// -unrevealed or by older versions read the same way; columns a CSV
+// doesn't have are left at their zero value, except that rows count as
+// revealed unless a revealed column says otherwise, and rows without a
+// season take the one given. Text is returned as written; see ReadNewlines
+// for CSVs written with parse -newlines=escape.
+func Read(r io.Reader, season string) ([]Clue, error) {
+	return ReadNewlines(r, season, "")
+}
+
+// reads one season CSV like Read, written with the parse.Options.Newlines
+// mode newlines: with parse.NewlinesEscape the \n of categories, clues,
+// notes and responses become line breaks again, as Load does from the
+// mode recorded in schema.json
+func ReadNewlines(r io.Reader, season, newlines string) ([]Clue, error) {
+	rows, err := newReader(r, season, newlines)
+	if err != nil {
+		return nil, err
+	}
+	var clues []Clue
+	for {
+		c, err := rows.next()
+		if err == io.EOF {
+			return clues, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		clues = append(clues, c)
+	}
+}
+
+// returns the parse.Options.Newlines mode recorded in the schema.json of a
+// CSV directory, empty if it has none or it can't be read
+func Newlines(dir string) string {
+	schema, err := parse.ReadSchema(dir)
+	if err != nil || schema == nil {
+		return ""
+	}
+	return schema.Newlines
+}
+
+// reader turns the rows of a season CSV into clues one at a time
+type reader struct {
+	cr     *csv.Reader
+	col    map[string]int
+	season string
+	// turn escaped line breaks back into newlines
+	unescape bool
+}
+
+// reads the header of a season CSV written with the newline mode newlines
+func newReader(r io.Reader, season, newlines string) (*reader, error) {
+	cr := csv.NewReader(parse.SkipBOM(r))
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	col := make(map[string]int)
+	for i, name := range header {
+		col[name] = i
+	}
+	for _, name := range []string{"epNum", "round_name", "daily_double"} {
+		if _, ok := col[name]; !ok {
+			return nil, fmt.Errorf("missing %s column", name)
+		}
+	}
+	return &reader{cr: cr, col: col, season: season, unescape: newlines == parse.NewlinesEscape}, nil
+}
+
+func (r *reader) field(row []string, name string) string {
+	if i, ok := r.col[name]; ok && i < len(row) {
+		return row[i]
+	}
+	return ""
+}
+
+// returns the next row's clue, io.EOF after the last
+func (r *reader) next() (Clue, error) {
+	row, err := r.cr.Read()
+	if err != nil {
+		return Clue{}, err
+	}
+	field := func(name string) string { return r.field(row, name) }
+	c := Clue{
+		Season:          field("season"),
+		GameID:          field("game_id"),
+		EpisodeNumber:   field("epNum"),
+		AirDate:         field("airDate"),
+		Tournament:      field("tournament"),
+		TournamentStage: field("tournament_stage"),
+		Host:            field("host"),
+		Format:          field("game_format"),
+		Clue: jarchive.Clue{
+			Round:         field("round_name"),
+			Category:      field("category"),
+			ValueRaw:      field("value_raw"),
+			DailyDouble:   field("daily_double") == "true",
+			Question:      field("question"),
+			Notes:         field("clue_notes"),
+			Answer:        field("answer"),
+			Revealed:      field("revealed") != "false",
+			TripleStumper: field("triple_stumper") == "true",
+		},
+	}
+	if c.Season == "" {
+		c.Season = r.season
+	}
+	if r.unescape {
+		c.Category = parse.UnescapeNewlines(c.Category)
+		c.Question = parse.UnescapeNewlines(c.Question)
+		c.Notes = parse.UnescapeNewlines(c.Notes)
+		c.Answer = parse.UnescapeNewlines(c.Answer)
+	}
+	c.Value, _ = strconv.Atoi(field("value"))
+	c.Column, _ = strconv.Atoi(field("board_column"))
+	c.Row, _ = strconv.Atoi(field("board_row"))
+	c.TournamentGame, _ = strconv.Atoi(field("tournament_game"))
+	return c, nil
+}
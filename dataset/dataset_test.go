@@ -0,0 +1,90 @@
+package dataset
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"j-parser-go/parse"
+)
+
+// parses the jarchive fixtures as one season with -newlines=escape and
+// with -newlines=preserve, and checks that Load reads the same clues,
+// line breaks included, back from both
+func TestNewlinesRoundTrip(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in ../jarchive/testdata")
+	}
+	archive := t.TempDir()
+	season := filepath.Join(archive, "season 1")
+	if err := os.Mkdir(season, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for i, fixture := range fixtures {
+		data, err := os.ReadFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(season, fmt.Sprintf("%d.html", i+1)), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	load := func(newlines string) []Clue {
+		t.Helper()
+		opts := parse.Options{ArchiveDir: archive, OutDir: t.TempDir(), Newlines: newlines, Quiet: true, NoProgress: true}
+		if _, err := parse.Run(opts); err != nil {
+			t.Fatalf("parse -newlines=%s: %v", newlines, err)
+		}
+		clues, err := Load(Options{Dir: opts.OutDir})
+		if err != nil {
+			t.Fatalf("Load -newlines=%s: %v", newlines, err)
+		}
+		return clues
+	}
+	escaped, preserved := load(parse.NewlinesEscape), load(parse.NewlinesPreserve)
+	if !reflect.DeepEqual(escaped, preserved) {
+		t.Error("clues read from escaped CSVs differ from those read from preserved ones")
+	}
+	breaks := 0
+	for _, c := range escaped {
+		breaks += strings.Count(c.Category+c.Question+c.Notes+c.Answer, "\n")
+	}
+	if breaks == 0 {
+		t.Error("no line breaks in the fixtures' clues; the round trip tests nothing")
+	}
+}
+
+// checks that ReadNewlines undoes the escaping of line breaks and
+// backslashes only in escape mode
+func TestReadNewlines(t *testing.T) {
+	csv := "epNum,round_name,daily_double,category,question,answer\n" +
+		`1,Jeopardy!,false,A\nB,"C:\\new\nline",back\slash` + "\n"
+	tests := []struct {
+		newlines                   string
+		category, question, answer string
+	}{
+		{parse.NewlinesEscape, "A\nB", `C:\new` + "\nline", `back\slash`},
+		{parse.NewlinesPreserve, `A\nB`, `C:\\new\nline`, `back\slash`},
+		{"", `A\nB`, `C:\\new\nline`, `back\slash`},
+	}
+	for _, tt := range tests {
+		clues, err := ReadNewlines(strings.NewReader(csv), "1", tt.newlines)
+		if err != nil {
+			t.Fatalf("%q: %v", tt.newlines, err)
+		}
+		if len(clues) != 1 {
+			t.Fatalf("%q: got %d clues, want 1", tt.newlines, len(clues))
+		}
+		c := clues[0]
+		if c.Category != tt.category || c.Question != tt.question || c.Answer != tt.answer {
+			t.Errorf("%q: got %q, %q, %q; want %q, %q, %q", tt.newlines, c.Category, c.Question, c.Answer, tt.category, tt.question, tt.answer)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"j-parser-go/download"
+)
+
+// Changes is what changed between two sets of clues, such as two releases
+// of the parsed CSVs
+type Changes struct {
+	// games only in the new clues, and only in the old ones
+	AddedGames   []GameRef `json:"addedGames"`
+	RemovedGames []GameRef `json:"removedGames"`
+	// clues of games in both whose columns differ
+	ChangedClues []ClueChange `json:"changedClues"`
+	// clues of games in both that only one of them has
+	AddedClues   []ClueRef `json:"addedClues"`
+	RemovedClues []ClueRef `json:"removedClues"`
+}
+
+// GameRef is a game added or removed
+type GameRef struct {
+	Season        string `json:"season"`
+	EpisodeNumber string `json:"epNum"`
+	AirDate       string `json:"airDate"`
+	Clues         int    `json:"clues"`
+}
+
+// ClueRef names a clue by its game and place on the board
+type ClueRef struct {
+	Season        string `json:"season"`
+	EpisodeNumber string `json:"epNum"`
+	Round         string `json:"round"`
+	// 0 for Final Jeopardy and the tiebreaker
+	Column   int    `json:"column"`
+	Row      int    `json:"row"`
+	Category string `json:"category"`
+}
+
+// ClueChange is a clue whose columns differ between the old and new clues
+type ClueChange struct {
+	ClueRef
+	Fields []FieldChange `json:"fields"`
+}
+
+// FieldChange is one column of a changed clue
+type FieldChange struct {
+	Column string `json:"column"`
+	Old    string `json:"old"`
+	New    string `json:"new"`
+}
+
+// columns Diff leaves out: the game, round and board position identify the
+// clue, as does clue_id, and answer_normalized changes with answer
+var diffSkipped = map[string]bool{
+	"clue_id": true, "season": true, "epNum": true, "round_name": true, "board_column": true, "board_row": true, "answer_normalized": true,
+}
+
+// compares two sets of clues, games by season and show number and their
+// clues by round and board position, with games and clues in season and
+// show number order
+func Diff(old, new []Clue) *Changes {
+	oldGames, newGames := diffGames(old), diffGames(new)
+	ch := &Changes{AddedGames: []GameRef{}, RemovedGames: []GameRef{}, ChangedClues: []ClueChange{},
+		AddedClues: []ClueRef{}, RemovedClues: []ClueRef{}}
+	for _, key := range sortedGameKeys(oldGames, newGames) {
+		o, n := oldGames[key], newGames[key]
+		switch {
+		case o == nil:
+			ch.AddedGames = append(ch.AddedGames, gameRef(n))
+		case n == nil:
+			ch.RemovedGames = append(ch.RemovedGames, gameRef(o))
+		default:
+			ch.diffClues(o, n)
+		}
+	}
+	return ch
+}
+
+// diffKey is a game by season and show number
+type diffKey struct{ season, episode string }
+
+// diffGame is one game's clues, by place on the board
+type diffGame struct {
+	clues []*Clue
+	// the clue at each place, and the places in the order first seen
+	byPlace map[string]*Clue
+	places  []string
+}
+
+// groups clues into their games
+func diffGames(clues []Clue) map[diffKey]*diffGame {
+	games := make(map[diffKey]*diffGame)
+	for i := range clues {
+		c := &clues[i]
+		key := diffKey{c.Season, c.EpisodeNumber}
+		g := games[key]
+		if g == nil {
+			g = &diffGame{byPlace: make(map[string]*Clue)}
+			games[key] = g
+		}
+		g.clues = append(g.clues, c)
+		place := c.place()
+		for base, n := place, 2; g.byPlace[place] != nil; n++ {
+			place = base + "|" + strconv.Itoa(n)
+		}
+		g.byPlace[place] = c
+		g.places = append(g.places, place)
+	}
+	return games
+}
+
+// returns the games of both sets in season and show number order
+func sortedGameKeys(sets ...map[diffKey]*diffGame) []diffKey {
+	var keys []diffKey
+	seen := make(map[diffKey]bool)
+	for _, set := range sets {
+		for key := range set {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].season != keys[j].season {
+			return download.SeasonLess(keys[i].season, keys[j].season)
+		}
+		return download.SeasonLess(keys[i].episode, keys[j].episode)
+	})
+	return keys
+}
+
+func gameRef(g *diffGame) GameRef {
+	c := g.clues[0]
+	return GameRef{Season: c.Season, EpisodeNumber: c.EpisodeNumber, AirDate: c.AirDate, Clues: len(g.clues)}
+}
+
+func clueRef(c *Clue) ClueRef {
+	return ClueRef{Season: c.Season, EpisodeNumber: c.EpisodeNumber, Round: c.Round, Column: c.Column, Row: c.Row, Category: c.Category}
+}
+
+// adds the clue changes between two versions of a game
+func (ch *Changes) diffClues(old, new *diffGame) {
+	for _, place := range old.places {
+		o, n := old.byPlace[place], new.byPlace[place]
+		if n == nil {
+			ch.RemovedClues = append(ch.RemovedClues, clueRef(o))
+			continue
+		}
+		var fields []FieldChange
+		for _, col := range columns {
+			if diffSkipped[col.name] {
+				continue
+			}
+			if ov, nv := csvField(col.value(o)), csvField(col.value(n)); ov != nv {
+				fields = append(fields, FieldChange{Column: col.name, Old: ov, New: nv})
+			}
+		}
+		if len(fields) > 0 {
+			ch.ChangedClues = append(ch.ChangedClues, ClueChange{ClueRef: clueRef(n), Fields: fields})
+		}
+	}
+	for _, place := range new.places {
+		if old.byPlace[place] == nil {
+			ch.AddedClues = append(ch.AddedClues, clueRef(new.byPlace[place]))
+		}
+	}
+}
+
+// reports whether nothing changed
+func (ch *Changes) Empty() bool {
+	return len(ch.AddedGames)+len(ch.RemovedGames)+len(ch.ChangedClues)+len(ch.AddedClues)+len(ch.RemovedClues) == 0
+}
+
+// writes the changes as a changelog: a line per game or clue, "+" for
+// added, "-" for removed and "~" for changed with a line per column, then
+// a line of totals
+func (ch *Changes) WriteText(w io.Writer) {
+	for _, g := range ch.AddedGames {
+		fmt.Fprintf(w, "+ game %s\n", g)
+	}
+	for _, g := range ch.RemovedGames {
+		fmt.Fprintf(w, "- game %s\n", g)
+	}
+	for _, c := range ch.ChangedClues {
+		fmt.Fprintf(w, "~ clue %s\n", c.ClueRef)
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "    %s: %q -> %q\n", f.Column, f.Old, f.New)
+		}
+	}
+	for _, c := range ch.AddedClues {
+		fmt.Fprintf(w, "+ clue %s\n", c)
+	}
+	for _, c := range ch.RemovedClues {
+		fmt.Fprintf(w, "- clue %s\n", c)
+	}
+	fmt.Fprintf(w, "%d games added, %d removed; %d clues changed, %d added, %d removed\n",
+		len(ch.AddedGames), len(ch.RemovedGames), len(ch.ChangedClues), len(ch.AddedClues), len(ch.RemovedClues))
+}
+
+// writes the changes as indented JSON
+func (ch *Changes) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(ch)
+}
+
+// e.g. "40/9000 (2023-09-11, 61 clues)"
+func (g GameRef) String() string {
+	s := g.Season + "/" + g.EpisodeNumber + " ("
+	if g.AirDate != "" {
+		s += g.AirDate + ", "
+	}
+	return s + strconv.Itoa(g.Clues) + " clues)"
+}
+
+// e.g. "40/9000 Double Jeopardy column 1 row 1 (ART)"
+func (c ClueRef) String() string {
+	s := c.Season + "/" + c.EpisodeNumber + " " + c.Round
+	if c.Column != 0 || c.Row != 0 {
+		s += fmt.Sprintf(" column %d row %d", c.Column, c.Row)
+	}
+	if c.Category != "" {
+		s += " (" + c.Category + ")"
+	}
+	return s
+}
@@ -0,0 +1,86 @@
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"j-parser-go/download"
+)
+
+// returns where a clue was in its game: the round and board position, and
+// for clues without a position, from CSVs written before there was one, the
+// category and value as well
+func (c *Clue) place() string {
+	place := c.Round + "|" + strconv.Itoa(c.Column) + "|" + strconv.Itoa(c.Row)
+	if c.Column == 0 && c.Row == 0 {
+		place += "|" + c.Category + "|" + strconv.Itoa(c.Value)
+	}
+	return place
+}
+
+// reads a season CSV, taking the season from each row's season column and
+// its newline mode from the schema.json next to it, or a JSON array of
+// clues as WriteJSON writes them
+func ReadFile(path string) ([]Clue, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var clues []Clue
+		if err := json.NewDecoder(f).Decode(&clues); err != nil {
+			return nil, fmt.Errorf("error reading %s: %v", path, err)
+		}
+		return clues, nil
+	}
+	season, _ := strings.CutPrefix(strings.TrimSuffix(filepath.Base(path), ".csv"), "j-archive-season-")
+	clues, err := ReadNewlines(f, season, Newlines(filepath.Dir(path)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", path, err)
+	}
+	return clues, nil
+}
+
+// combines sets of clues into one, with every clue once and in the order
+// parse writes them: by season and show number, and within a game by
+// category, then value with Daily Doubles after the regular clues. A clue
+// that is in several sets, the same game, round and board position, is
+// taken from the last of them, so a fresher parse can be merged over an
+// older one.
+func Merge(sets ...[]Clue) []Clue {
+	type key struct{ season, episode, place string }
+	index := make(map[key]int)
+	var merged []Clue
+	for _, set := range sets {
+		for _, c := range set {
+			k := key{c.Season, c.EpisodeNumber, c.place()}
+			if i, ok := index[k]; ok {
+				merged[i] = c
+				continue
+			}
+			index[k] = len(merged)
+			merged = append(merged, c)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		a, b := &merged[i], &merged[j]
+		switch {
+		case a.Season != b.Season:
+			return download.SeasonLess(a.Season, b.Season)
+		case a.EpisodeNumber != b.EpisodeNumber:
+			return download.SeasonLess(a.EpisodeNumber, b.EpisodeNumber)
+		case a.Category != b.Category:
+			return a.Category < b.Category
+		case a.DailyDouble != b.DailyDouble:
+			return !a.DailyDouble
+		}
+		return a.Value < b.Value
+	})
+	return merged
+}
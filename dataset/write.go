@@ -0,0 +1,198 @@
+package dataset
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"j-parser-go/jarchive"
+)
+
+// column is one field of a clue as the writers output it; value returns a
+// string, an int or a bool
+type column struct {
+	name  string
+	value func(c *Clue) any
+}
+
+// every column in the parse CSV's order, ending with revealed, which they
+// only have with -unrevealed and Header leaves out
+var columns = []column{
+	{"clue_id", func(c *Clue) any { return c.ID() }},
+	{"season", func(c *Clue) any { return c.Season }},
+	{"game_id", func(c *Clue) any { return c.GameID }},
+	{"epNum", func(c *Clue) any { return c.EpisodeNumber }},
+	{"airDate", func(c *Clue) any { return c.AirDate }},
+	{"round_name", func(c *Clue) any { return c.Round }},
+	{"category", func(c *Clue) any { return c.Category }},
+	{"value", func(c *Clue) any { return c.Value }},
+	{"value_raw", func(c *Clue) any { return c.ValueRaw }},
+	{"daily_double", func(c *Clue) any { return c.DailyDouble }},
+	{"board_column", func(c *Clue) any { return c.Column }},
+	{"board_row", func(c *Clue) any { return c.Row }},
+	{"question", func(c *Clue) any { return c.Question }},
+	{"clue_notes", func(c *Clue) any { return c.Notes }},
+	{"answer", func(c *Clue) any { return c.Answer }},
+	{"answer_normalized", func(c *Clue) any { return jarchive.NormalizeAnswer(c.Answer) }},
+	{"triple_stumper", func(c *Clue) any { return c.TripleStumper }},
+	{"tournament", func(c *Clue) any { return c.Tournament }},
+	{"tournament_stage", func(c *Clue) any { return c.TournamentStage }},
+	{"tournament_game", func(c *Clue) any { return c.TournamentGame }},
+	{"host", func(c *Clue) any { return c.Host }},
+	{"game_format", func(c *Clue) any { return c.Format }},
+	{"revealed", func(c *Clue) any { return c.Revealed }},
+}
+
+// Header is the first line WriteCSV writes, the parse CSV's columns
+var Header = func() []string {
+	var names []string
+	for _, c := range columns[:len(columns)-1] {
+		names = append(names, c.name)
+	}
+	return names
+}()
+
+// returns the clue as a CSV row in Header's order
+func (c *Clue) Record() []string {
+	row := make([]string, len(Header))
+	for i := range Header {
+		row[i] = csvField(columns[i].value(c))
+	}
+	return row
+}
+
+// formats a column value as the parse CSVs do, with 0 as an empty string
+func csvField(v any) string {
+	switch v := v.(type) {
+	case int:
+		if v == 0 {
+			return ""
+		}
+		return strconv.Itoa(v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return v.(string)
+}
+
+// looks up the named columns, in order; names may be any of Header's and
+// revealed. No names means Header.
+func selectColumns(names []string) ([]column, error) {
+	if len(names) == 0 {
+		return columns[:len(Header)], nil
+	}
+	var selected []column
+	for _, name := range names {
+		i := columnIndex(name)
+		if i < 0 {
+			var all []string
+			for _, c := range columns {
+				all = append(all, c.name)
+			}
+			return nil, fmt.Errorf("unknown column %q (want one of %s)", name, strings.Join(all, ", "))
+		}
+		selected = append(selected, columns[i])
+	}
+	return selected, nil
+}
+
+func columnIndex(name string) int {
+	for i, c := range columns {
+		if c.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// returns an error naming the first of names that isn't a column
+func CheckColumns(names []string) error {
+	_, err := selectColumns(names)
+	return err
+}
+
+// writes clues as CSV, Header first
+func WriteCSV(w io.Writer, clues []Clue) error {
+	return WriteCSVColumns(w, clues, nil)
+}
+
+// writes clues as CSV with only the named columns, in the order given;
+// with no names it writes what WriteCSV does
+func WriteCSVColumns(w io.Writer, clues []Clue, names []string) error {
+	cols, err := selectColumns(names)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	row := make([]string, len(cols))
+	for i, col := range cols {
+		row[i] = col.name
+	}
+	cw.Write(row)
+	for i := range clues {
+		for j, col := range cols {
+			row[j] = csvField(col.value(&clues[i]))
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes clues as an indented JSON array
+func WriteJSON(w io.Writer, clues []Clue) error {
+	if clues == nil {
+		clues = []Clue{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(clues)
+}
+
+// writes clues as an indented JSON array of objects keyed by the named
+// columns, in the order given, with numbers and booleans as JSON ones;
+// with no names it writes what WriteJSON does
+func WriteJSONColumns(w io.Writer, clues []Clue, names []string) error {
+	if len(names) == 0 {
+		return WriteJSON(w, clues)
+	}
+	cols, err := selectColumns(names)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	// Encode adds a newline after each value
+	encode := func(v any) string {
+		buf.Reset()
+		enc.Encode(v)
+		return strings.TrimSuffix(buf.String(), "\n")
+	}
+	var out strings.Builder
+	out.WriteString("[")
+	for i := range clues {
+		if i > 0 {
+			out.WriteString(",")
+		}
+		out.WriteString("\n  {")
+		for j, col := range cols {
+			if j > 0 {
+				out.WriteString(",")
+			}
+			fmt.Fprintf(&out, "\n    %s: %s", encode(col.name), encode(col.value(&clues[i])))
+		}
+		out.WriteString("\n  }")
+	}
+	if len(clues) > 0 {
+		out.WriteString("\n")
+	}
+	out.WriteString("]\n")
+	_, err = io.WriteString(w, out.String())
+	return err
+}
@@ -0,0 +1,87 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// folder of the archive directory holding cached season pages
+const cacheDir = ".cache"
+
+// how long a cached season page is used for when Options.SeasonPageTTL is
+// zero
+const DefaultSeasonPageTTL = 6 * time.Hour
+
+// returns where a season's page is cached in an archive directory. Every
+// download run that reads the season page leaves it there, unless caching is
+// turned off with a negative Options.SeasonPageTTL.
+func SeasonPageFile(archiveDir, season string) string {
+	return filepath.Join(archiveDir, cacheDir, seasonPageName(season))
+}
+
+// name of a season page in the cache folder
+func seasonPageName(season string) string {
+	return fmt.Sprintf("season-%s.html", season)
+}
+
+// returns a listing page, the season list or a season's page, from the
+// cache in the archive if it was saved there within the TTL and from the
+// site otherwise, along with the URL links on it are relative to. name is
+// the page's file in the cache; a fetched page is saved there only if save
+// is set, which plans leave off.
+func (d *Downloader) listingPage(ctx context.Context, name, pageURL string, save bool) ([]byte, *url.URL, error) {
+	cached := filepath.Join(d.opts.ArchiveDir, cacheDir, name)
+	// streamed runs keep nothing on disk
+	caching := d.opts.OnBody == nil && d.opts.SeasonPageTTL > 0
+	if caching && !d.opts.ForceRefresh {
+		if info, err := os.Stat(cached); err == nil && time.Since(info.ModTime()) < d.opts.SeasonPageTTL {
+			if body, err := os.ReadFile(cached); err == nil {
+				slog.Debug("using cached page", "url", pageURL, "file", cached, "age", time.Since(info.ModTime()).Round(time.Second))
+				page, err := url.Parse(pageURL)
+				if err != nil {
+					return nil, nil, err
+				}
+				return body, page, nil
+			}
+		}
+	}
+
+	resp, err := d.get(ctx, pageURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("HTTP GET error: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status fetching %s: %s", pageURL, resp.Status)
+	}
+	d.followRedirect(resp)
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading %s: %v", pageURL, err)
+	}
+	if caching && save {
+		if err := writeAtomic(cached, body); err != nil {
+			slog.Warn("error caching page", "url", pageURL, "file", cached, "err", err)
+		}
+	}
+	return body, resp.Request.URL, nil
+}
+
+// writes a file through a partial file, so that a run killed part way
+// through doesn't leave half of it to be read back
+func writeAtomic(path string, body []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path+".partial", body, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(path+".partial", path)
+}
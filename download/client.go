@@ -0,0 +1,60 @@
+package download
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// longest one attempt at a request may take, body included. The waits for
+// the rate limit and Retry-After before it don't count, so it is applied
+// per attempt by limitedTransport rather than as the client's Timeout.
+var requestTimeout = time.Minute
+
+const (
+	// idle connections kept open to J! Archive; more than Concurrency is
+	// never needed since the rate limit spaces the requests out anyway
+	maxIdleConns = 16
+)
+
+// the clients every Downloader without Options.Client shares, so that
+// connections are kept alive across seasons and, in the daemon, across runs
+var (
+	sharedClient      = sync.OnceValue(func() *http.Client { return newClient(true) })
+	sharedHTTP1Client = sync.OnceValue(func() *http.Client { return newClient(false) })
+)
+
+// returns the shared client, speaking HTTP/2 to servers that offer it if
+// http2 is set and only HTTP/1.1 otherwise
+func defaultClient(http2 bool) *http.Client {
+	if http2 {
+		return sharedClient()
+	}
+	return sharedHTTP1Client()
+}
+
+// returns a client with a pool of keep-alive connections and timeouts on
+// every stage of a request; limitClient adds the one on the whole attempt
+func newClient(http2 bool) *http.Client {
+	t := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     http2,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConns,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	if !http2 {
+		// a non-nil empty map turns HTTP/2 off
+		t.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return &http.Client{Transport: t}
+}
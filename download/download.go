@@ -1,167 +1,630 @@
 package download
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"math/rand/v2"
 	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"j-parser-go/internal/logging"
+	"j-parser-go/metrics"
+	"j-parser-go/tracing"
 )
 
 const (
-	baseURL           = "http://j-archive.com"
-	seasonURLTemplate = "http://j-archive.com/showseason.php?season=%d"
-	gameURLTemplate   = "http://j-archive.com/showgame.php?game_id=%s"
-	siteFolder        = "season-archive"
-	latestSeason      = 41
+	DefaultBaseURL = "https://j-archive.com"
+	// paths relative to the base URL
+	seasonListPath     = "/listseasons.php"
+	seasonPathTemplate = "/showseason.php?season=%s"
+	gamePathTemplate   = "/showgame.php?game_id=%s"
+	siteFolder         = "season-archive"
+	// used only when the season list can't be fetched
+	fallbackSeason = "41"
 )
 
+// spans of season and episode downloads
+var tracer = tracing.Tracer("j-parser-go/download")
+
 var (
-	episodeRe = regexp.MustCompile(`^(https?://(www\.)?j-archive\.com/)?showgame\.php\?game_id=\d+$`)
-	epIdRe    = regexp.MustCompile(`game_id=(\d+)`)
-	epNumRe   = regexp.MustCompile(`#(\d{1,4})`)
+	epNumRe    = regexp.MustCompile(`#(\d{1,4})`)
+	seasonIDRe = regexp.MustCompile(`^[A-Za-z0-9]+$`)
 )
 
-func Run(seasons []int) {
-	// Default to downloading season 41 if none provided
+// Options configures a Downloader. The zero value downloads from J! Archive
+// into season-archive with the default client and politeness delays.
+type Options struct {
+	// client used for every request; if nil, one with a pool of keep-alive
+	// connections shared by every Downloader
+	Client *http.Client
+	// keep the shared client to HTTP/1.1; ignored when Client is set
+	DisableHTTP2 bool
+	// site root, DefaultBaseURL if empty; tests point this at a mock server.
+	// When the site redirects to another scheme or to or from www., the
+	// Downloader carries on from there.
+	BaseURL string
+	// re-download episodes that were saved previously
+	Refresh bool
+	// with Refresh, only re-download files older than this; when zero the
+	// remote Last-Modified header decides instead
+	OlderThan time.Duration
+	// don't draw the progress display
+	NoProgress bool
+	// directory the season folders are written to, "season-archive" if empty
+	ArchiveDir string
+	// number of seasons downloaded at once, twice the CPU count if zero
+	Concurrency int
+	// requests made to J! Archive per minute, by all of the Downloader's
+	// seasons together; DefaultRequestsPerMinute if zero
+	RequestsPerMinute float64
+	// a random wait in [MinDelay, MaxDelay] follows each episode download
+	// of a season, on top of the rate limit; none if both are zero
+	MinDelay, MaxDelay time.Duration
+	// also save each episode's showscores.php page, the scores after every
+	// clue, to ScoresDir in its season folder
+	Scores bool
+	// after the seasons, also save the showplayer.php page of every
+	// contestant in them to PlayersDir: those missing from the archive, and
+	// everyone who played in an episode the run downloaded
+	Players bool
+	// how long a season page, or the season list, saved under .cache in
+	// ArchiveDir is used for instead of fetching it again;
+	// DefaultSeasonPageTTL if zero, never cached if negative
+	SeasonPageTTL time.Duration
+	// fetch season pages even when the cached copy is within SeasonPageTTL
+	ForceRefresh bool
+	// called after each episode file is saved, and after each season has
+	// been fully processed; the sync command uses these to parse as it goes
+	OnSaved      func(season, file string)
+	OnSeasonDone func(season string)
+	// when set, episode pages are handed to this function instead of being
+	// written to the archive directory; existing files are ignored
+	OnBody func(season, episode string, body []byte)
+}
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.Client == nil {
+		o.Client = defaultClient(!o.DisableHTTP2)
+	}
+	if o.BaseURL == "" {
+		o.BaseURL = DefaultBaseURL
+	}
+	o.BaseURL = strings.TrimSuffix(o.BaseURL, "/")
+	if o.ArchiveDir == "" {
+		o.ArchiveDir = siteFolder
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = runtime.NumCPU() * 2
+	}
+	if o.RequestsPerMinute <= 0 {
+		o.RequestsPerMinute = DefaultRequestsPerMinute
+	}
+	if o.SeasonPageTTL == 0 {
+		o.SeasonPageTTL = DefaultSeasonPageTTL
+	}
+	if o.MaxDelay < o.MinDelay {
+		o.MaxDelay = o.MinDelay
+	}
+}
+
+// random pause between episode downloads
+func (o *Options) delay() time.Duration {
+	if o.MaxDelay == o.MinDelay {
+		return o.MinDelay
+	}
+	return o.MinDelay + rand.N(o.MaxDelay-o.MinDelay+1)
+}
+
+// Downloader fetches season listings and game pages from J! Archive into an
+// archive directory
+type Downloader struct {
+	opts Options
+	mu   sync.Mutex
+	// BaseURL, or where the site redirected it to
+	base string
+}
+
+// creates a Downloader, filling in defaults for unset options
+func New(opts Options) *Downloader {
+	opts.setDefaults()
+	opts.Client = metrics.Client(limitClient(opts.Client, opts.RequestsPerMinute))
+	return &Downloader{opts: opts, base: opts.BaseURL}
+}
+
+// downloads the given seasons with a Downloader built from opts
+func Run(seasons []string, opts Options) (Result, error) {
+	return New(opts).Run(seasons)
+}
+
+// like Run, stopping once ctx is done, see Downloader.RunContext
+func RunContext(ctx context.Context, seasons []string, opts Options) (Result, error) {
+	return New(opts).RunContext(ctx, seasons)
+}
+
+// fetches the season list from J! Archive with the default client
+func ListSeasons() ([]string, error) {
+	return New(Options{}).ListSeasons()
+}
+
+func (d *Downloader) url(pathFormat string, args ...any) string {
+	return d.baseURL() + fmt.Sprintf(pathFormat, args...)
+}
+
+// Run downloads every episode of the given seasons that isn't already on
+// disk; with no seasons it downloads the most recent one. Season identifiers
+// are strings because J! Archive has named seasons (superjeopardy,
+// trebekpilots, ...) alongside the numbered ones. Episodes that fail are
+// logged and counted in the Result rather than stopping the run.
+func (d *Downloader) Run(seasons []string) (Result, error) {
+	return d.RunContext(context.Background(), seasons)
+}
+
+// like Run, but once ctx is done it stops between episodes, abandoning the
+// requests and waits under way, saves the manifest and returns the totals
+// so far, with Result.Unfinished listing the seasons it didn't get to the
+// end of, and ctx's error. Episodes already saved are skipped by the next
+// run, so running it again with the unfinished seasons carries on from
+// there. OnSeasonDone isn't called for the unfinished seasons.
+func (d *Downloader) RunContext(ctx context.Context, seasons []string) (Result, error) {
+	opts := d.opts
+	// Default to downloading the most recent season if none provided
 	if len(seasons) == 0 {
-		seasons = []int{latestSeason}
+		latest, err := d.LatestSeason()
+		if err != nil {
+			slog.Warn("could not detect latest season, using fallback", "season", fallbackSeason, "err", err)
+			latest = fallbackSeason
+		}
+		seasons = []string{latest}
 	}
 
-	err := os.MkdirAll(siteFolder, os.ModePerm)
+	err := os.MkdirAll(opts.ArchiveDir, os.ModePerm)
 	if err != nil {
-		log.Fatalf("Error creating directory %s: %v", siteFolder, err)
+		return Result{}, fmt.Errorf("error creating archive directory %s: %v", opts.ArchiveDir, err)
 	}
 
-	numThreads := runtime.NumCPU() * 2
-	fmt.Printf("Using %d threads\n", numThreads)
+	manifest, err := loadManifest(opts.ArchiveDir)
+	if err != nil {
+		return Result{}, err
+	}
+
+	prog := newProgress(!opts.NoProgress)
+	if prog.line.Enabled() {
+		defer logging.Redirect(prog.line)()
+	}
 
+	numThreads := opts.Concurrency
+	slog.Info("starting download", "threads", numThreads, "seasons", len(seasons))
+
+	res := &tally{res: Result{Seasons: seasons}}
+	players := &playerSet{ids: make(map[string]bool)}
 	var wg sync.WaitGroup
-	seasonChan := make(chan int, numThreads)
+	seasonChan := make(chan string, numThreads)
 
-	for _, season := range seasons {
-		wg.Add(1)
+	for i, season := range seasons {
 		seasonChan <- season
-		go func(season int) {
+		if ctx.Err() != nil {
+			res.stopped(seasons[i:]...)
+			break
+		}
+		wg.Add(1)
+		go func(season string) {
 			defer wg.Done()
-			downloadSeason(season)
+			if d.downloadSeason(ctx, season, manifest, prog, res, players) {
+				res.stopped(season)
+			} else if opts.OnSeasonDone != nil {
+				opts.OnSeasonDone(season)
+			}
 			<-seasonChan
 		}(season)
 	}
 
 	wg.Wait()
-}
+	prog.finish()
+	if opts.Players && opts.OnBody == nil && ctx.Err() == nil {
+		d.downloadPlayers(ctx, players, res)
+	}
 
-// downloads a season page, parses it for episode links, and downloads each episode's HTML
-func downloadSeason(season int) {
-	fmt.Printf("Downloading Season %d\n", season)
-	seasonFolder := filepath.Join(siteFolder, fmt.Sprintf("season %d", season))
-	// Create season folder if needed
-	if err := os.MkdirAll(seasonFolder, os.ModePerm); err != nil {
-		log.Printf("Error creating season folder %s: %v", seasonFolder, err)
-		return
+	if err := manifest.save(); err != nil {
+		slog.Error("error saving manifest", "file", manifest.path, "err", err)
+	}
+	if rejected := manifest.Rejected(); len(rejected) > 0 {
+		slog.Warn("some episodes were rejected", "count", len(rejected), "manifest", manifest.path)
+	}
+	if err := ctx.Err(); err != nil {
+		res.sortUnfinished(seasons)
+		slog.Warn("download cancelled", "downloaded", res.res.Downloaded, "skipped", res.res.Skipped, "failed", res.res.Failed,
+			"unfinished", res.res.Unfinished)
+		return res.res, err
 	}
+	return res.res, nil
+}
+
+// fetches listseasons.php and returns every season identifier it links to,
+// including named seasons such as "superjeopardy" or "trebekpilots"
+func (d *Downloader) ListSeasons() ([]string, error) {
+	return d.listSeasons(true)
+}
+
+// like ListSeasons, for planning: a cached season list is used, but one
+// that has to be fetched isn't saved to the archive
+func (d *Downloader) PlanSeasons() ([]string, error) {
+	return d.listSeasons(false)
+}
 
-	// Download the season page
-	seasonURL := fmt.Sprintf(seasonURLTemplate, season)
-	resp, err := http.Get(seasonURL)
+// returns the seasons on listseasons.php, caching the page if save is set
+func (d *Downloader) listSeasons(save bool) ([]string, error) {
+	seasonListURL := d.url(seasonListPath)
+	body, page, err := d.listingPage(context.Background(), "listseasons.html", seasonListURL, save)
 	if err != nil {
-		log.Printf("Error downloading season page %s: %v", seasonURL, err)
-		return
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	// Load the HTML document
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
-		log.Printf("Error parsing season page %s: %v", seasonURL, err)
-		return
+		return nil, fmt.Errorf("error parsing season list: %v", err)
 	}
 
-	// Collect episode links and their text
-	var episodeLinks []string
-	var linkTexts []string
+	var seasons []string
+	seen := make(map[string]bool)
 	doc.Find("a").Each(func(i int, s *goquery.Selection) {
 		href, exists := s.Attr("href")
-		if exists && episodeRe.MatchString(href) {
-			episodeLinks = append(episodeLinks, href)
-			linkTexts = append(linkTexts, s.Text())
+		if !exists {
+			return
+		}
+		if season, ok := seasonLink(page, href); ok && !seen[season] {
+			seen[season] = true
+			seasons = append(seasons, season)
 		}
 	})
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("no seasons found at %s", seasonListURL)
+	}
+	return seasons, nil
+}
+
+// returns the highest numbered season listed on the site
+func (d *Downloader) LatestSeason() (string, error) {
+	return d.latestSeason(true)
+}
 
-	fmt.Printf("Found %d episode links in Season %d\n", len(episodeLinks), season)
+func (d *Downloader) latestSeason(save bool) (string, error) {
+	ids, err := d.listSeasons(save)
+	if err != nil {
+		return "", err
+	}
+	latest := -1
+	for _, id := range ids {
+		if num, err := strconv.Atoi(id); err == nil && num > latest {
+			latest = num
+		}
+	}
+	if latest < 0 {
+		return "", fmt.Errorf("no numbered seasons found at %s", d.url(seasonListPath))
+	}
+	return strconv.Itoa(latest), nil
+}
 
-	// Reverse slices to process links in correct order
-	reverseStrings(episodeLinks)
-	reverseStrings(linkTexts)
+// reports whether id looks like a J! Archive season identifier ("41", "superjeopardy")
+func ValidSeason(id string) bool {
+	return seasonIDRe.MatchString(id)
+}
 
-	// Loop through each episode link and extract episode numbers and IDs
-	for i, link := range episodeLinks {
-		match := epNumRe.FindStringSubmatch(linkTexts[i])
-		if len(match) < 2 {
-			log.Printf("Episode number not found in text: %s", linkTexts[i])
-			continue
+// downloads a season page, parses it for episode links, and downloads each
+// episode's HTML. true if ctx was done before the last episode.
+func (d *Downloader) downloadSeason(ctx context.Context, season string, manifest *Manifest, prog *progress, res *tally, players *playerSet) bool {
+	opts := d.opts
+	slog.Info("downloading season", "season", season)
+	ctx, seasonSpan := tracer.Start(ctx, "download season", trace.WithAttributes(attribute.String("season", season)))
+	defer seasonSpan.End()
+	seasonFolder := filepath.Join(opts.ArchiveDir, fmt.Sprintf("season %s", season))
+	// Create season folder if needed; streamed episodes are never written
+	if opts.OnBody == nil {
+		if err := os.MkdirAll(seasonFolder, os.ModePerm); err != nil {
+			slog.Error("error creating season folder", "season", season, "dir", seasonFolder, "err", err)
+			res.seasonFailed(season, err)
+			tracing.End(seasonSpan, tracing.Failed, err)
+			return false
 		}
-		episodeNumber := match[1]
-		gameFile := filepath.Join(seasonFolder, fmt.Sprintf("%s.html", episodeNumber))
+	}
+
+	episodes, listed, err := d.seasonEpisodes(ctx, season, seasonFolder, true)
+	if ctx.Err() != nil {
+		return true
+	}
+	if err != nil {
+		slog.Error("error reading season page", "season", season, "url", d.url(seasonPathTemplate, season), "err", err)
+		res.seasonFailed(season, err)
+		tracing.End(seasonSpan, tracing.Failed, err)
+		return false
+	}
+	if opts.OnBody == nil {
+		if err := writeSeasonIndex(seasonFolder, episodes); err != nil {
+			slog.Warn("error writing season index", "season", season, "err", err)
+		}
+	}
+	seasonSpan.SetAttributes(attribute.Int("episodes", listed))
+	prog.addSeason(season, listed)
+	// links without an episode number were logged and are skipped
+	for range listed - len(episodes) {
+		prog.episodeDone(season, false)
+	}
 
-		if _, err := os.Stat(gameFile); err == nil {
+	for _, ep := range episodes {
+		if ctx.Err() != nil {
+			slog.Info("season stopped", "season", season)
+			return true
+		}
+		episodeNumber, episodeID, gameURL, gameFile := ep.Episode, ep.GameID, ep.URL, ep.File
+		if !d.wanted(ctx, ep) {
+			prog.episodeDone(season, false)
+			res.update(func(r *Result) { r.Skipped++ })
+			d.downloadScores(ctx, season, ep, res)
+			if opts.Players && opts.OnBody == nil {
+				players.addGame(gameFile, false)
+			}
 			continue
 		}
+		slog.Debug("downloading episode", "season", season, "epNum", episodeNumber, "url", gameURL)
+		_, span := tracer.Start(ctx, "download episode", trace.WithAttributes(attribute.String("season", season),
+			attribute.String("episode", episodeNumber), attribute.String("game_id", episodeID)))
+
+		entry := ManifestEntry{
+			Season:  season,
+			Episode: episodeNumber,
+			GameID:  episodeID,
+			URL:     gameURL,
+			File:    gameFile,
+			Status:  statusSaved,
+		}
+		if opts.OnBody != nil {
+			entry.File = ""
+			var body []byte
+			body, err = d.fetchGamePage(ctx, gameURL)
+			if err == nil {
+				opts.OnBody(season, episodeNumber, body)
+			}
+		} else {
+			err = d.downloadFile(ctx, gameURL, gameFile)
+		}
+		var rejected *rejectError
+		if err != nil && ctx.Err() != nil {
+			// left for the next run, not a failure
+			span.End()
+			slog.Info("season stopped", "season", season)
+			return true
+		} else if errors.As(err, &rejected) {
+			slog.Warn("rejected episode", "season", season, "epNum", episodeNumber, "url", gameURL, "reason", rejected.reason)
+			entry.Status = statusRejected
+			entry.Reason = rejected.reason
+			manifest.record(entry)
+			res.update(func(r *Result) { r.Rejected++ })
+			tracing.End(span, tracing.Rejected, err)
+		} else if err != nil {
+			slog.Error("error downloading episode", "season", season, "epNum", episodeNumber, "url", gameURL, "err", err)
+			res.episodeFailed(season, episodeNumber, err)
+			tracing.End(span, tracing.Failed, err)
+		} else {
+			manifest.record(entry)
+			res.update(func(r *Result) { r.Downloaded++ })
+			tracing.End(span, tracing.Downloaded, nil)
+			if opts.OnSaved != nil {
+				opts.OnSaved(season, gameFile)
+			}
+			d.downloadScores(ctx, season, ep, res)
+			if opts.Players && opts.OnBody == nil {
+				players.addGame(gameFile, true)
+			}
+		}
+		prog.episodeDone(season, true)
+		if delay := opts.delay(); delay > 0 {
+			wait(ctx, delay)
+		}
+	}
+
+	slog.Info("season finished", "season", season)
+	return false
+}
+
+// episode is one game linked from a season page
+type episode struct {
+	Episode string
+	GameID  string
+	URL     string
+	// where the page is saved in the archive
+	File string
+	// what the season page says about the game
+	listing IndexedEpisode
+}
+
+// fetches a season page and returns its episodes oldest first, along with
+// the number of episode links on the page; links whose text has no episode
+// number are logged and left out. The page is cached if save is set.
+func (d *Downloader) seasonEpisodes(ctx context.Context, season, seasonFolder string, save bool) ([]episode, int, error) {
+	seasonURL := d.url(seasonPathTemplate, season)
+	body, page, err := d.listingPage(ctx, seasonPageName(season), seasonURL, save)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	listings, err := ParseSeasonPage(bytes.NewReader(body), page)
+	if err != nil {
+		return nil, 0, err
+	}
+	slog.Info("found episode links", "season", season, "count", len(listings))
 
-		matchID := epIdRe.FindStringSubmatch(link)
-		if len(matchID) < 2 {
-			log.Printf("Game id not found in link: %s", link)
+	var episodes []episode
+	for _, l := range listings {
+		if l.Episode == "" {
+			slog.Warn("episode number not found in link text", "season", season, "game_id", l.GameID)
 			continue
 		}
-		episodeID := matchID[1]
-		gameURL := fmt.Sprintf(gameURLTemplate, episodeID)
-		fmt.Printf("Downloading Episode %s from Season %d\n", episodeNumber, season)
+		episodes = append(episodes, episode{
+			Episode: l.Episode,
+			GameID:  l.GameID,
+			URL:     d.url(gamePathTemplate, l.GameID),
+			File:    filepath.Join(seasonFolder, fmt.Sprintf("%s.html", l.Episode)),
+			listing: l,
+		})
+	}
+	return episodes, len(listings), nil
+}
 
-		err = downloadFile(gameURL, gameFile)
-		if err != nil {
-			log.Printf("Error downloading episode %s: %v", episodeNumber, err)
+// reports whether Run would fetch the episode: it is streamed, not on disk
+// yet (or only as an empty file) or due for a refresh
+func (d *Downloader) wanted(ctx context.Context, ep episode) bool {
+	if d.opts.OnBody != nil {
+		return true
+	}
+	info, err := os.Stat(ep.File)
+	return err != nil || info.Size() == 0 || d.needsRefresh(ctx, ep.URL, info)
+}
+
+// decides whether an already-downloaded episode should be fetched again
+func (d *Downloader) needsRefresh(ctx context.Context, url string, info os.FileInfo) bool {
+	opts := d.opts
+	if !opts.Refresh {
+		return false
+	}
+	if opts.OlderThan > 0 {
+		return time.Since(info.ModTime()) > opts.OlderThan
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		slog.Warn("error checking episode for changes", "url", url, "err", err)
+		return false
+	}
+	resp, err := opts.Client.Do(req)
+	if err != nil {
+		slog.Warn("error checking episode for changes", "url", url, "err", err)
+		return false
+	}
+	resp.Body.Close()
+	lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified"))
+	if err != nil {
+		// No usable Last-Modified header, so we can't tell; assume it changed
+		return true
+	}
+	return lastModified.After(info.ModTime())
+}
+
+// parses an age such as "30d", "12h" or "90m" into a duration; time.ParseDuration
+// doesn't understand days so they are handled here
+func ParseAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("invalid age %q", s)
 		}
-		// Wait 2-6 seconds between downloads to not overload the server
-		sleepTime := rand.IntN(6) + 2
-		time.Sleep(time.Duration(sleepTime) * time.Second)
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	return d, nil
+}
+
+// rejectError means the server answered but the page isn't a usable game
+type rejectError struct {
+	reason string
+}
+
+func (e *rejectError) Error() string {
+	return "invalid game page: " + e.reason
+}
+
+// downloads HTML content from each URL and saves it to a file, refusing to
+// write error or placeholder pages
+func (d *Downloader) downloadFile(ctx context.Context, url string, filepath string) error {
+	body, err := d.fetchGamePage(ctx, url)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath, body, 0o644); err != nil {
+		return fmt.Errorf("error writing to file: %v", err)
 	}
+	return nil
+}
 
-	fmt.Printf("Season %d finished\n", season)
+// sends a GET for url that is abandoned once ctx is done
+func (d *Downloader) get(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return d.opts.Client.Do(req)
 }
 
-// downloads HTML content from each URL and saves it to a file
-func downloadFile(url string, filepath string) error {
-	resp, err := http.Get(url)
+// downloads a game page and returns its body once it has been validated
+func (d *Downloader) fetchGamePage(ctx context.Context, url string) ([]byte, error) {
+	resp, err := d.get(ctx, url)
 	if err != nil {
-		return fmt.Errorf("HTTP GET error: %v", err)
+		return nil, fmt.Errorf("HTTP GET error: %v", err)
 	}
 	defer resp.Body.Close()
+	d.followRedirect(resp)
 
-	out, err := os.Create(filepath)
+	// the page may well be fine later, so this is a failure, not a rejection
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		return nil, fmt.Errorf("server busy: %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("file creation error: %v", err)
+		return nil, fmt.Errorf("error reading response: %v", err)
 	}
-	defer out.Close()
+	if err := validateGamePage(resp.StatusCode, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
 
-	_, err = io.Copy(out, resp.Body)
+// checks that a response looks like a real showgame.php page: a 200 status
+// and either a game title or a Jeopardy round in the body
+func validateGamePage(status int, body []byte) error {
+	if status != http.StatusOK {
+		return &rejectError{reason: fmt.Sprintf("HTTP status %d", status)}
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
-		return fmt.Errorf("error writing to file: %v", err)
+		return &rejectError{reason: fmt.Sprintf("unparseable HTML: %v", err)}
+	}
+	if doc.Find("#jeopardy_round").Length() == 0 && doc.Find("#game_title").Length() == 0 {
+		return &rejectError{reason: "no #jeopardy_round or #game_title element"}
 	}
 	return nil
 }
 
-// helper to reverse a slice of strings in place
-func reverseStrings(s []string) {
-	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
-		s[i], s[j] = s[j], s[i]
+// pauses between requests, counting the pause for /metrics; ctx's error
+// if it is done first
+func wait(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	metrics.Waited(d)
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
 	}
 }
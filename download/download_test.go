@@ -0,0 +1,276 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// a game page validateGamePage accepts
+const gamePage = `<html><body><div id="game_title"><h1>Show #%s</h1></div><div id="jeopardy_round"></div></body></html>`
+
+// site serves a season 41 page linking to three games, the last of which
+// is a placeholder J! Archive hasn't archived yet, and counts the
+// requests for each path
+type site struct {
+	*httptest.Server
+	mu   sync.Mutex
+	hits map[string]int
+}
+
+func newSite(t *testing.T) *site {
+	t.Helper()
+	s := &site{hits: make(map[string]int)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/showseason.php", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<table>
+<tr><td><a href="showgame.php?game_id=7202">#9202, aired 2024-09-11</a></td></tr>
+<tr><td><a href="showgame.php?game_id=7201">#9201, aired 2024-09-10</a></td></tr>
+<tr><td><a href="showgame.php?game_id=7200">#9200, aired 2024-09-09</a></td></tr>
+</table>`)
+	})
+	mux.HandleFunc("/showgame.php", func(w http.ResponseWriter, r *http.Request) {
+		switch id := r.URL.Query().Get("game_id"); id {
+		case "7200", "7201":
+			fmt.Fprintf(w, gamePage, id)
+		default:
+			fmt.Fprint(w, `<html><body><p>This game is not yet archived.</p></body></html>`)
+		}
+	})
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.hits[r.URL.Path]++
+		s.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// returns how many requests were made for path
+func (s *site) count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.hits[path]
+}
+
+// options for downloading from s into dir without the politeness delays
+// or waiting on the rate limit
+func (s *site) options(dir string) Options {
+	return Options{BaseURL: s.URL, ArchiveDir: dir, Client: s.Client(), Concurrency: 1, RequestsPerMinute: 60000, MinDelay: time.Nanosecond, NoProgress: true}
+}
+
+// downloads a season, checking what is saved and recorded, then again to
+// check that saved episodes are skipped and the season page is cached
+func TestRun(t *testing.T) {
+	s := newSite(t)
+	dir := t.TempDir()
+	res, err := Run([]string{"41"}, s.options(dir))
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if res.Downloaded != 2 || res.Rejected != 1 || res.Failed != 0 || res.Skipped != 0 {
+		t.Errorf("got %d downloaded, %d rejected, %d failed and %d skipped; want 2, 1, 0 and 0", res.Downloaded, res.Rejected, res.Failed, res.Skipped)
+	}
+	for _, ep := range []string{"9200", "9201"} {
+		if _, err := os.Stat(filepath.Join(dir, "season 41", ep+".html")); err != nil {
+			t.Errorf("episode %s not saved: %v", ep, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "season 41", "9202.html")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("the placeholder page was saved (err %v)", err)
+	}
+	entries, err := ReadManifest(dir)
+	if err != nil {
+		t.Fatalf("ReadManifest: %v", err)
+	}
+	status := make(map[string]string)
+	for _, e := range entries {
+		status[e.Episode] = e.Status
+	}
+	want := map[string]string{"9200": statusSaved, "9201": statusSaved, "9202": statusRejected}
+	for ep, st := range want {
+		if status[ep] != st {
+			t.Errorf("manifest has episode %s as %q, want %q", ep, status[ep], st)
+		}
+	}
+
+	res, err = Run([]string{"41"}, s.options(dir))
+	if err != nil {
+		t.Fatalf("second Run: %v", err)
+	}
+	if res.Downloaded != 0 || res.Skipped != 2 || res.Rejected != 1 {
+		t.Errorf("second run: got %d downloaded, %d skipped and %d rejected; want 0, 2 and 1", res.Downloaded, res.Skipped, res.Rejected)
+	}
+	// the season page comes from the cache the second time
+	if n := s.count("/showseason.php"); n != 1 {
+		t.Errorf("season page fetched %d times, want 1", n)
+	}
+}
+
+// cancels a run before it starts and checks that the season is left
+// unfinished, nothing is downloaded and the manifest is still saved
+func TestRunContextCancelled(t *testing.T) {
+	s := newSite(t)
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := RunContext(ctx, []string{"41", "40"}, s.options(dir))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("RunContext returned %v, want context.Canceled", err)
+	}
+	if res.Downloaded != 0 || len(res.Unfinished) != 2 || res.Unfinished[0] != "41" || res.Unfinished[1] != "40" {
+		t.Errorf("got %d downloaded and unfinished %v; want 0 and [41 40]", res.Downloaded, res.Unfinished)
+	}
+	if n := s.count("/showgame.php"); n != 0 {
+		t.Errorf("%d game pages fetched after the context was done", n)
+	}
+	if _, err := os.Stat(filepath.Join(dir, manifestFile)); err != nil {
+		t.Errorf("manifest not saved: %v", err)
+	}
+}
+
+// plans a season, checking what would be fetched and that nothing is
+// written, not even the season page cache
+func TestPlan(t *testing.T) {
+	s := newSite(t)
+	dir := t.TempDir()
+	season := filepath.Join(dir, "season 41")
+	if err := os.Mkdir(season, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(season, "9200.html"), []byte(fmt.Sprintf(gamePage, "9200")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	plan, err := New(s.options(dir)).Plan([]string{"41"})
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+	if len(plan.Seasons) != 1 {
+		t.Fatalf("got %d seasons, want 1", len(plan.Seasons))
+	}
+	sp := plan.Seasons[0]
+	if sp.Err != nil {
+		t.Fatalf("season 41: %v", sp.Err)
+	}
+	var got []string
+	for _, ep := range sp.Episodes {
+		got = append(got, ep.Episode)
+	}
+	if sp.Listed != 3 || fmt.Sprint(got) != "[9201 9202]" {
+		t.Errorf("got %d listed and episodes %v; want 3 and [9201 9202]", sp.Listed, got)
+	}
+	if n := s.count("/showgame.php"); n != 0 {
+		t.Errorf("%d game pages fetched while planning", n)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "season 41" {
+		var names []string
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		t.Errorf("planning left %v in the archive, want only the season folder", names)
+	}
+}
+
+func TestValidateGamePage(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		ok     bool
+	}{
+		{"game", http.StatusOK, fmt.Sprintf(gamePage, "9200"), true},
+		{"round only", http.StatusOK, `<div id="jeopardy_round"></div>`, true},
+		{"title only", http.StatusOK, `<div id="game_title"></div>`, true},
+		{"placeholder", http.StatusOK, `<p>This game is not yet archived.</p>`, false},
+		{"error status", http.StatusNotFound, fmt.Sprintf(gamePage, "9200"), false},
+	}
+	for _, tt := range tests {
+		err := validateGamePage(tt.status, []byte(tt.body))
+		var rejected *rejectError
+		switch {
+		case tt.ok && err != nil:
+			t.Errorf("%s: got %v, want no error", tt.name, err)
+		case !tt.ok && !errors.As(err, &rejected):
+			t.Errorf("%s: got %v, want a rejection", tt.name, err)
+		}
+	}
+}
+
+// checks when a saved episode is due to be fetched again, by its age with
+// OlderThan and by the server's Last-Modified otherwise
+func TestNeedsRefresh(t *testing.T) {
+	lastModified := time.Now().Add(-2 * time.Hour)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("modified") != "" {
+			w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+		}
+	}))
+	defer srv.Close()
+	file := filepath.Join(t.TempDir(), "9200.html")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		refresh   bool
+		olderThan time.Duration
+		modTime   time.Time
+		query     string
+		want      bool
+	}{
+		{"no refresh", false, 0, time.Now().Add(-48 * time.Hour), "?modified=1", false},
+		{"older than", true, time.Hour, time.Now().Add(-3 * time.Hour), "", true},
+		{"newer than", true, 4 * time.Hour, time.Now().Add(-3 * time.Hour), "", false},
+		{"modified since saved", true, 0, time.Now().Add(-3 * time.Hour), "?modified=1", true},
+		{"saved since modified", true, 0, time.Now().Add(-time.Hour), "?modified=1", false},
+		{"no Last-Modified", true, 0, time.Now(), "", true},
+	}
+	for _, tt := range tests {
+		if err := os.Chtimes(file, tt.modTime, tt.modTime); err != nil {
+			t.Fatal(err)
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d := New(Options{Client: srv.Client(), Refresh: tt.refresh, OlderThan: tt.olderThan, RequestsPerMinute: 60000})
+		if got := d.needsRefresh(context.Background(), srv.URL+"/showgame.php"+tt.query, info); got != tt.want {
+			t.Errorf("%s: got %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+// doesn't send the HEAD request once the run's context is done
+func TestNeedsRefreshCancelled(t *testing.T) {
+	s := newSite(t)
+	file := filepath.Join(t.TempDir(), "9200.html")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	opts := s.options(t.TempDir())
+	opts.Refresh = true
+	if New(opts).needsRefresh(ctx, s.URL+"/showgame.php?game_id=7200", info) {
+		t.Error("got a refresh after the context was done")
+	}
+	if n := s.count("/showgame.php"); n != 0 {
+		t.Errorf("%d requests sent after the context was done", n)
+	}
+}
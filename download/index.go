@@ -0,0 +1,120 @@
+package download
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// file in each season folder listing the season's episodes
+const SeasonIndexFile = "season-index.json"
+
+// IndexedEpisode is an episode as the season page lists it, without its
+// game being downloaded or parsed
+type IndexedEpisode struct {
+	GameID  string `json:"game_id"`
+	Episode string `json:"episode"`
+	// YYYY-MM-DD, empty if the page doesn't give one
+	AirDate string `json:"air_date,omitempty"`
+	// the page's note on the game, e.g. "Tournament of Champions final game 1."
+	Title       string   `json:"title,omitempty"`
+	Contestants []string `json:"contestants,omitempty"`
+}
+
+// "#9000, aired 2023-10-13", with a non-breaking space before the date
+var airedRe = regexp.MustCompile(`aired[\s\x{a0}]*(\d{4}-\d{2}-\d{2})`)
+
+// parses a season page, as served from page, into the episodes it links to,
+// oldest first. An episode whose link text has no show number has an empty
+// Episode.
+func ParseSeasonPage(r io.Reader, page *url.URL) ([]IndexedEpisode, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing season page: %v", err)
+	}
+	var listings []IndexedEpisode
+	doc.Find("a").Each(func(i int, s *goquery.Selection) {
+		href, _ := s.Attr("href")
+		if id, ok := gameLink(page, href); ok {
+			listings = append(listings, indexedEpisode(s, id))
+		}
+	})
+	// the page lists the newest episode first
+	slices.Reverse(listings)
+	return listings, nil
+}
+
+// fills in what the season page's table row around an episode link says
+// about the episode: the show number and air date in the link, then the
+// contestants and the note in the cells after it
+func indexedEpisode(link *goquery.Selection, gameID string) IndexedEpisode {
+	ie := IndexedEpisode{GameID: gameID}
+	if m := epNumRe.FindStringSubmatch(link.Text()); m != nil {
+		ie.Episode = m[1]
+	}
+	if m := airedRe.FindStringSubmatch(link.Text()); m != nil {
+		ie.AirDate = m[1]
+	}
+	cells := link.Closest("tr").Children().Filter("td")
+	if cells.Length() < 2 {
+		return ie
+	}
+	for _, c := range strings.Split(cellText(cells.Eq(1)), " vs. ") {
+		if c = strings.TrimSpace(c); c != "" {
+			ie.Contestants = append(ie.Contestants, c)
+		}
+	}
+	if cells.Length() > 2 {
+		ie.Title = cellText(cells.Eq(2))
+	}
+	return ie
+}
+
+// a cell's text with its whitespace, non-breaking spaces included, collapsed
+func cellText(s *goquery.Selection) string {
+	return strings.Join(strings.Fields(s.Text()), " ")
+}
+
+// writes the season's index to its folder, oldest episode first
+func writeSeasonIndex(seasonFolder string, episodes []episode) error {
+	index := make([]IndexedEpisode, 0, len(episodes))
+	for _, ep := range episodes {
+		index = append(index, ep.listing)
+	}
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding season index: %v", err)
+	}
+	path := filepath.Join(seasonFolder, SeasonIndexFile)
+	if err := writeAtomic(path, data); err != nil {
+		return fmt.Errorf("error writing season index %s: %v", path, err)
+	}
+	return nil
+}
+
+// returns the episodes in a season folder's index, none if the season
+// hasn't been downloaded since indexes were added
+func ReadSeasonIndex(seasonFolder string) ([]IndexedEpisode, error) {
+	path := filepath.Join(seasonFolder, SeasonIndexFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading season index %s: %v", path, err)
+	}
+	var index []IndexedEpisode
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("error decoding season index %s: %v", path, err)
+	}
+	return index, nil
+}
@@ -0,0 +1,156 @@
+package download
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// requests per minute a Downloader makes to J! Archive when
+// Options.RequestsPerMinute is zero
+const DefaultRequestsPerMinute = 12
+
+const (
+	// times a request is retried after the server asked to back off
+	maxRetries = 3
+	// longest Retry-After waited for; a longer one fails the request
+	maxRetryAfter = 15 * time.Minute
+	// the wait after a 429 that doesn't say how long to wait
+	defaultRetryAfter = time.Minute
+)
+
+// limitedTransport holds every request made through it to a rate shared by
+// all of a Downloader's goroutines, however many seasons run at once, and
+// pauses all of them when the server answers 429 or 503 with Retry-After
+type limitedTransport struct {
+	next    http.RoundTripper
+	limiter *rate.Limiter
+
+	mu sync.Mutex
+	// no request goes out before this, after a Retry-After
+	resume time.Time
+}
+
+// returns a copy of c whose requests, all together, keep to perMinute
+func limitClient(c *http.Client, perMinute float64) *http.Client {
+	limited := *c
+	limited.Transport = &limitedTransport{
+		next:    c.Transport,
+		limiter: rate.NewLimiter(rate.Every(requestInterval(perMinute)), 1),
+	}
+	return &limited
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	for attempt := 1; ; attempt++ {
+		if err := t.waitTurn(req.Context()); err != nil {
+			return nil, err
+		}
+		resp, err := sendOnce(next, req)
+		if err != nil {
+			return nil, err
+		}
+		after, ok := retryAfter(resp, time.Now())
+		// a request with a body can't be sent again
+		if !ok || (req.Body != nil && req.Body != http.NoBody) {
+			return resp, nil
+		}
+		if attempt > maxRetries || after > maxRetryAfter {
+			slog.Warn("server asked to back off, giving up on request", "url", req.URL.String(), "status", resp.StatusCode,
+				"retry_after", after, "attempts", attempt)
+			return resp, nil
+		}
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		resp.Body.Close()
+		slog.Warn("server asked to back off, pausing all downloads", "url", req.URL.String(), "status", resp.StatusCode,
+			"retry_after", after, "attempt", attempt)
+		t.pause(after)
+	}
+}
+
+// waits out any Retry-After, then for the request's turn under the rate,
+// giving up when ctx is done
+func (t *limitedTransport) waitTurn(ctx context.Context) error {
+	t.mu.Lock()
+	resume := t.resume
+	t.mu.Unlock()
+	if err := wait(ctx, time.Until(resume)); err != nil {
+		return err
+	}
+	r := t.limiter.Reserve()
+	if err := wait(ctx, r.Delay()); err != nil {
+		r.Cancel()
+		return err
+	}
+	return nil
+}
+
+// sends req once, giving the attempt requestTimeout to complete, reading
+// the body included
+func sendOnce(next http.RoundTripper, req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), requestTimeout)
+	resp, err := next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{resp.Body, cancel}
+	return resp, nil
+}
+
+// cancelBody releases an attempt's timeout once its body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// holds back every request for d from now, unless an earlier Retry-After
+// already does for longer
+func (t *limitedTransport) pause(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := time.Now().Add(d); until.After(t.resume) {
+		t.resume = until
+	}
+}
+
+// returns how long the server asked to wait before trying again: a 429 or
+// 503 with a Retry-After of seconds or an HTTP date, or any 429. ok is false
+// for every other response.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	header := resp.Header.Get("Retry-After")
+	if secs, err := strconv.Atoi(header); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(header); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return defaultRetryAfter, true
+	}
+	return 0, false
+}
+
+// time between requests at perMinute
+func requestInterval(perMinute float64) time.Duration {
+	return time.Duration(float64(time.Minute) / perMinute)
+}
@@ -0,0 +1,165 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// serves 429s with the given Retry-After for the first busy requests, then
+// 200s, counting the requests
+func busyServer(t *testing.T, busy int32, retryAfter string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= busy {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+// retries a request the server asked to back off from once Retry-After has
+// passed
+func TestRetryAfter(t *testing.T) {
+	srv, hits := busyServer(t, 1, "1")
+	client := limitClient(srv.Client(), 60000)
+	start := time.Now()
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d, want 200", resp.StatusCode)
+	}
+	if n := hits.Load(); n != 2 {
+		t.Errorf("got %d requests, want 2", n)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, before the 1s Retry-After", elapsed)
+	}
+}
+
+// hands back the last 429 once maxRetries retries were refused too
+func TestRetryAfterGivesUp(t *testing.T) {
+	srv, hits := busyServer(t, 100, "0")
+	resp, err := limitClient(srv.Client(), 60000).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got status %d, want 429", resp.StatusCode)
+	}
+	if n := hits.Load(); n != maxRetries+1 {
+		t.Errorf("got %d requests, want %d", n, maxRetries+1)
+	}
+}
+
+// stops waiting out a Retry-After once the request's context is done
+func TestRetryAfterCancelled(t *testing.T) {
+	srv, hits := busyServer(t, 100, "60")
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now()
+	_, err = limitClient(srv.Client(), 60000).Do(req)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Errorf("gave up after %s, not when the context was done", elapsed)
+	}
+	if n := hits.Load(); n != 1 {
+		t.Errorf("got %d requests, want 1", n)
+	}
+}
+
+// times each attempt on its own: a Retry-After wait longer than
+// requestTimeout doesn't fail the request, an attempt longer than it does
+func TestRequestTimeout(t *testing.T) {
+	defer func(d time.Duration) { requestTimeout = d }(requestTimeout)
+	requestTimeout = 500 * time.Millisecond
+
+	srv, _ := busyServer(t, 1, "1")
+	resp, err := limitClient(srv.Client(), 60000).Get(srv.URL)
+	if err != nil {
+		t.Fatalf("Get after Retry-After: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d after Retry-After, want 200", resp.StatusCode)
+	}
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(5 * time.Second):
+		case <-r.Context().Done():
+		}
+	}))
+	defer slow.Close()
+	start := time.Now()
+	if _, err := limitClient(slow.Client(), 60000).Get(slow.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("slow attempt: got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 3*time.Second {
+		t.Errorf("slow attempt took %s, more than requestTimeout", elapsed)
+	}
+}
+
+// holds requests to the rate: the second of two requests waits its turn
+func TestRateLimit(t *testing.T) {
+	srv, _ := busyServer(t, 0, "")
+	client := limitClient(srv.Client(), 120)
+	start := time.Now()
+	for range 2 {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("two requests at 120 a minute took %s, want about 500ms", elapsed)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 9, 9, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		status int
+		header string
+		want   time.Duration
+		ok     bool
+	}{
+		{"seconds", http.StatusTooManyRequests, "30", 30 * time.Second, true},
+		{"date", http.StatusServiceUnavailable, now.Add(time.Minute).Format(http.TimeFormat), time.Minute, true},
+		{"past date", http.StatusTooManyRequests, now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"429 without header", http.StatusTooManyRequests, "", defaultRetryAfter, true},
+		{"503 without header", http.StatusServiceUnavailable, "", 0, false},
+		{"ok", http.StatusOK, "30", 0, false},
+	}
+	for _, tt := range tests {
+		resp := &http.Response{StatusCode: tt.status, Header: http.Header{}}
+		if tt.header != "" {
+			resp.Header.Set("Retry-After", tt.header)
+		}
+		got, ok := retryAfter(resp, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("%s: got %s, %t; want %s, %t", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package download
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+)
+
+var gameIDRe = regexp.MustCompile(`^\d+$`)
+
+// returns the game id an episode link on page points to. Links may be
+// relative or absolute, to http or https and with or without www.
+func gameLink(page *url.URL, href string) (string, bool) {
+	q, ok := siteLink(page, href, "showgame.php")
+	if id := q.Get("game_id"); ok && gameIDRe.MatchString(id) {
+		return id, true
+	}
+	return "", false
+}
+
+// returns the season a season link on page points to, as gameLink does
+func seasonLink(page *url.URL, href string) (string, bool) {
+	q, ok := siteLink(page, href, "showseason.php")
+	if season := q.Get("season"); ok && ValidSeason(season) {
+		return season, true
+	}
+	return "", false
+}
+
+// resolves href against page and returns its query if it is a link to
+// script on the same site
+func siteLink(page *url.URL, href, script string) (url.Values, bool) {
+	u, err := page.Parse(strings.TrimSpace(href))
+	if err != nil || !sameSite(u, page) || path.Base(u.Path) != script {
+		return nil, false
+	}
+	return u.Query(), true
+}
+
+// reports whether a and b are the same site, whatever their schemes and
+// ignoring a leading www.
+func sameSite(a, b *url.URL) bool {
+	host := func(u *url.URL) string { return strings.TrimPrefix(strings.ToLower(u.Host), "www.") }
+	return host(a) == host(b)
+}
+
+// switches the Downloader to the scheme and host the site redirected a
+// request to, such as https://j-archive.com for http://j-archive.com, so
+// that later requests go there directly rather than being redirected too
+func (d *Downloader) followRedirect(resp *http.Response) {
+	final := resp.Request.URL
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	base, err := url.Parse(d.base)
+	if err != nil || !sameSite(final, base) || (final.Scheme == base.Scheme && final.Host == base.Host) {
+		return
+	}
+	base.Scheme, base.Host = final.Scheme, final.Host
+	slog.Info("following redirect", "from", d.base, "to", base.String())
+	d.base = base.String()
+}
+
+// returns the site root requests currently go to
+func (d *Downloader) baseURL() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.base
+}
@@ -0,0 +1,124 @@
+package download
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const manifestFile = "manifest.json"
+
+// outcome of a single episode download
+const (
+	statusSaved    = "saved"
+	statusRejected = "rejected"
+)
+
+// ManifestEntry records what happened the last time an episode was fetched
+type ManifestEntry struct {
+	Season    string    `json:"season"`
+	Episode   string    `json:"episode"`
+	GameID    string    `json:"game_id"`
+	URL       string    `json:"url"`
+	File      string    `json:"file"`
+	Status    string    `json:"status"`
+	Reason    string    `json:"reason,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Manifest is the on-disk record of downloads, keyed by game id. It is safe
+// for concurrent use by the season goroutines.
+type Manifest struct {
+	mu      sync.Mutex
+	path    string
+	entries map[string]ManifestEntry
+}
+
+// loads the manifest from dir, returning an empty one if none exists yet
+func loadManifest(dir string) (*Manifest, error) {
+	m := &Manifest{
+		path:    filepath.Join(dir, manifestFile),
+		entries: make(map[string]ManifestEntry),
+	}
+	entries, err := ReadManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		m.entries[e.GameID] = e
+	}
+	return m, nil
+}
+
+// returns the entries of the manifest in an archive directory, none if it
+// has no manifest yet
+func ReadManifest(dir string) ([]ManifestEntry, error) {
+	path := filepath.Join(dir, manifestFile)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading manifest %s: %v", path, err)
+	}
+	var entries []ManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("error decoding manifest %s: %v", path, err)
+	}
+	return entries, nil
+}
+
+// stores the entry, replacing any previous record for the same game
+func (m *Manifest) record(e ManifestEntry) {
+	if e.Timestamp.IsZero() {
+		e.Timestamp = time.Now().UTC()
+	}
+	m.mu.Lock()
+	m.entries[e.GameID] = e
+	m.mu.Unlock()
+}
+
+// returns the entries that were rejected, sorted by season then episode
+func (m *Manifest) Rejected() []ManifestEntry {
+	var rejected []ManifestEntry
+	for _, e := range m.sorted() {
+		if e.Status == statusRejected {
+			rejected = append(rejected, e)
+		}
+	}
+	return rejected
+}
+
+func (m *Manifest) sorted() []ManifestEntry {
+	m.mu.Lock()
+	entries := make([]ManifestEntry, 0, len(m.entries))
+	for _, e := range m.entries {
+		entries = append(entries, e)
+	}
+	m.mu.Unlock()
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Season != entries[j].Season {
+			return entries[i].Season < entries[j].Season
+		}
+		return entries[i].GameID < entries[j].GameID
+	})
+	return entries
+}
+
+// writes the manifest back to disk
+func (m *Manifest) save() error {
+	data, err := json.MarshalIndent(m.sorted(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding manifest: %v", err)
+	}
+	tmp := m.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("error writing manifest %s: %v", tmp, err)
+	}
+	return os.Rename(tmp, m.path)
+}
@@ -0,0 +1,118 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+)
+
+// Plan is what Run would do for a set of seasons, worked out without
+// downloading any episodes or writing anything to disk
+type Plan struct {
+	Seasons []SeasonPlan
+	// expected run time from the rate limit, delays and concurrency; the
+	// time spent on the requests themselves isn't included
+	Estimate time.Duration
+}
+
+// SeasonPlan lists the episodes of one season that would be downloaded
+type SeasonPlan struct {
+	Season string
+	// episode links on the season page
+	Listed int
+	// episodes that would be fetched, oldest first
+	Episodes []PlannedEpisode
+	// set when the season page couldn't be read
+	Err error
+}
+
+// PlannedEpisode is an episode Run would fetch
+type PlannedEpisode struct {
+	Episode string
+	GameID  string
+	URL     string
+	// where it would be saved, empty when streaming
+	File string
+}
+
+// fetches the season pages and works out which episodes Run would download.
+// Only season pages are requested, plus HEAD requests when Refresh relies on
+// Last-Modified; nothing is written, not even the season pages to the
+// cache, though cached ones are used.
+func (d *Downloader) Plan(seasons []string) (*Plan, error) {
+	if len(seasons) == 0 {
+		latest, err := d.latestSeason(false)
+		if err != nil {
+			return nil, fmt.Errorf("could not detect latest season: %v", err)
+		}
+		seasons = []string{latest}
+	}
+	plan := &Plan{}
+	// seasons run Concurrency at a time, each starting as soon as a slot is
+	// free, so hand every season to the slot that frees up first
+	slots := make([]time.Duration, min(d.opts.Concurrency, len(seasons)))
+	perEpisode := (d.opts.MinDelay + d.opts.MaxDelay) / 2
+	for _, season := range seasons {
+		sp := SeasonPlan{Season: season}
+		seasonFolder := filepath.Join(d.opts.ArchiveDir, fmt.Sprintf("season %s", season))
+		episodes, listed, err := d.seasonEpisodes(context.Background(), season, seasonFolder, false)
+		sp.Listed, sp.Err = listed, err
+		for _, ep := range episodes {
+			if !d.wanted(context.Background(), ep) {
+				continue
+			}
+			pe := PlannedEpisode{Episode: ep.Episode, GameID: ep.GameID, URL: ep.URL, File: ep.File}
+			if d.opts.OnBody != nil {
+				pe.File = ""
+			}
+			sp.Episodes = append(sp.Episodes, pe)
+		}
+		plan.Seasons = append(plan.Seasons, sp)
+
+		first := 0
+		for i := range slots {
+			if slots[i] < slots[first] {
+				first = i
+			}
+		}
+		slots[first] += time.Duration(len(sp.Episodes)) * perEpisode
+	}
+	for _, t := range slots {
+		plan.Estimate = max(plan.Estimate, t)
+	}
+	// however many seasons run at once, the episodes can't be fetched faster
+	// than the rate limit allows
+	plan.Estimate = max(plan.Estimate, time.Duration(plan.Total())*requestInterval(d.opts.RequestsPerMinute))
+	return plan, nil
+}
+
+// number of episodes the plan would download
+func (p *Plan) Total() int {
+	n := 0
+	for _, s := range p.Seasons {
+		n += len(s.Episodes)
+	}
+	return n
+}
+
+// prints the plan: every episode that would be downloaded, a line per season
+// and the totals
+func (p *Plan) Write(w io.Writer) {
+	for _, s := range p.Seasons {
+		if s.Err != nil {
+			fmt.Fprintf(w, "season %s: error reading season page: %v\n", s.Season, s.Err)
+			continue
+		}
+		fmt.Fprintf(w, "season %s: %d of %d episodes to download\n", s.Season, len(s.Episodes), s.Listed)
+		for _, ep := range s.Episodes {
+			if ep.File == "" {
+				fmt.Fprintf(w, "  #%s %s\n", ep.Episode, ep.URL)
+			} else {
+				fmt.Fprintf(w, "  #%s %s -> %s\n", ep.Episode, ep.URL, ep.File)
+			}
+		}
+	}
+	fmt.Fprintf(w, "%d episodes in %d seasons, estimated time %s\n", p.Total(), len(p.Seasons), p.Estimate.Round(time.Second))
+}
@@ -0,0 +1,122 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const playerPathTemplate = "/showplayer.php?player_id=%s"
+
+// folder of the archive directory that Options.Players saves player pages
+// to, named after the player id
+const PlayersDir = "players"
+
+var playerIDRe = regexp.MustCompile(`player_id=(\d+)`)
+
+// returns where a player's page is saved in an archive directory
+func PlayerFile(archiveDir, playerID string) string {
+	return filepath.Join(archiveDir, PlayersDir, playerID+".html")
+}
+
+// playerSet collects the contestants of a run's episodes from the season
+// goroutines
+type playerSet struct {
+	mu sync.Mutex
+	// player id to whether they played in an episode this run downloaded
+	ids map[string]bool
+}
+
+// records the players in a saved game page; fresh means the page was just
+// downloaded, so their own pages have a game more on them
+func (s *playerSet) addGame(file string, fresh bool) {
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	doc.Find(`#contestants a[href*="player_id="]`).Each(func(_ int, a *goquery.Selection) {
+		if m := playerIDRe.FindStringSubmatch(a.AttrOr("href", "")); m != nil {
+			s.ids[m[1]] = s.ids[m[1]] || fresh
+		}
+	})
+}
+
+// with Options.Players, saves the page of every player in the run's
+// seasons that isn't in the archive yet, and again for those who played in
+// an episode the run downloaded. Failures are logged and listed in the
+// Result.
+func (d *Downloader) downloadPlayers(ctx context.Context, players *playerSet, res *tally) {
+	var ids []string
+	for id, fresh := range players.ids {
+		file := PlayerFile(d.opts.ArchiveDir, id)
+		if info, err := os.Stat(file); err == nil && info.Size() > 0 && !fresh &&
+			!d.needsRefresh(ctx, d.url(playerPathTemplate, id), info) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	sort.Slice(ids, func(i, j int) bool { return SeasonLess(ids[i], ids[j]) })
+	slog.Info("downloading player pages", "players", len(ids))
+	if err := os.MkdirAll(filepath.Join(d.opts.ArchiveDir, PlayersDir), os.ModePerm); err != nil {
+		slog.Error("error creating players folder", "err", err)
+		res.update(func(r *Result) { r.Errors = append(r.Errors, fmt.Sprintf("players: %v", err)) })
+		return
+	}
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			return
+		}
+		url := d.url(playerPathTemplate, id)
+		body, err := d.fetchPlayerPage(ctx, url)
+		if err == nil {
+			err = writeAtomic(PlayerFile(d.opts.ArchiveDir, id), body)
+		}
+		if err != nil {
+			slog.Error("error downloading player page", "player_id", id, "url", url, "err", err)
+			res.update(func(r *Result) { r.Errors = append(r.Errors, fmt.Sprintf("player %s: %v", id, err)) })
+			continue
+		}
+		res.update(func(r *Result) { r.Players++ })
+	}
+}
+
+// downloads a player page, refusing anything without a link to a game,
+// which is what J! Archive serves for unknown ids
+func (d *Downloader) fetchPlayerPage(ctx context.Context, url string) ([]byte, error) {
+	resp, err := d.get(ctx, url)
+	if err != nil {
+		return nil, fmt.Errorf("HTTP GET error: %v", err)
+	}
+	defer resp.Body.Close()
+	d.followRedirect(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading response: %v", err)
+	}
+	if !bytes.Contains(body, []byte("showgame.php?game_id=")) {
+		return nil, fmt.Errorf("no games on player page")
+	}
+	return body, nil
+}
@@ -0,0 +1,96 @@
+package download
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"j-parser-go/internal/statusline"
+)
+
+const progressBarWidth = 30
+
+// progress keeps per-season and overall episode counts and shows them on a
+// status line
+type progress struct {
+	mu      sync.Mutex
+	line    *statusline.Line
+	started time.Time
+	order   []string
+	total   map[string]int
+	done    map[string]int
+	// episodes actually fetched from the server, used for the rate so that
+	// skipped files don't make the ETA look better than it is
+	fetched int
+}
+
+func newProgress(enabled bool) *progress {
+	p := &progress{
+		started: time.Now(),
+		total:   make(map[string]int),
+		done:    make(map[string]int),
+	}
+	p.line = statusline.New(enabled, p.render)
+	return p
+}
+
+// registers a season once its episode list is known
+func (p *progress) addSeason(season string, episodes int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.total[season]; !ok {
+		p.order = append(p.order, season)
+	}
+	p.total[season] = episodes
+}
+
+// marks one episode of the season as handled; fetched is true when it was
+// actually downloaded rather than skipped
+func (p *progress) episodeDone(season string, fetched bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done[season]++
+	if fetched {
+		p.fetched++
+	}
+}
+
+func (p *progress) finish() {
+	p.line.Finish()
+}
+
+func (p *progress) render() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	total, done := 0, 0
+	var seasons []string
+	for _, s := range p.order {
+		total += p.total[s]
+		done += p.done[s]
+		if p.done[s] < p.total[s] {
+			seasons = append(seasons, fmt.Sprintf("S%s %d/%d", s, p.done[s], p.total[s]))
+		}
+	}
+
+	filled := 0
+	percent := 0.0
+	if total > 0 {
+		filled = progressBarWidth * done / total
+		percent = 100 * float64(done) / float64(total)
+	}
+	bar := strings.Repeat("#", filled) + strings.Repeat(".", progressBarWidth-filled)
+
+	rate := float64(p.fetched) / time.Since(p.started).Minutes()
+	eta := "--"
+	if p.fetched > 0 && total > done {
+		remaining := time.Duration(float64(total-done) / rate * float64(time.Minute))
+		eta = remaining.Round(time.Second).String()
+	}
+
+	line := fmt.Sprintf("[%s] %d/%d (%.0f%%) %.1f ep/min ETA %s", bar, done, total, percent, rate, eta)
+	if len(seasons) > 0 {
+		line += " | " + strings.Join(seasons, " ")
+	}
+	return line
+}
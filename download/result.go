@@ -0,0 +1,75 @@
+package download
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Result summarizes a download run
+type Result struct {
+	// the seasons asked for, in order
+	Seasons []string
+	// episodes saved, or handed to OnBody, by this run
+	Downloaded int
+	// episodes left alone because they were already on disk
+	Skipped int
+	// pages J! Archive served that weren't game pages, as recorded in the
+	// manifest
+	Rejected int
+	// episodes that failed to download
+	Failed int
+	// scores pages saved with Options.Scores
+	Scores int
+	// player pages saved with Options.Players
+	Players int
+	// with RunContext, the seasons it stopped in or didn't start because
+	// its context was done, in the order they were asked for
+	Unfinished []string
+	// what went wrong for each season whose page couldn't be read and each
+	// episode that failed, e.g. "season 41 episode 9123: ..."
+	Errors []string
+}
+
+// tally collects a Result from the season goroutines
+type tally struct {
+	mu  sync.Mutex
+	res Result
+}
+
+func (t *tally) update(f func(*Result)) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f(&t.res)
+}
+
+func (t *tally) seasonFailed(season string, err error) {
+	t.update(func(r *Result) { r.Errors = append(r.Errors, fmt.Sprintf("season %s: %v", season, err)) })
+}
+
+// records seasons left unfinished when the run's context was done
+func (t *tally) stopped(seasons ...string) {
+	t.update(func(r *Result) { r.Unfinished = append(r.Unfinished, seasons...) })
+}
+
+// puts the unfinished seasons back in the order they were asked for
+func (t *tally) sortUnfinished(seasons []string) {
+	t.update(func(r *Result) {
+		left := make(map[string]bool, len(r.Unfinished))
+		for _, season := range r.Unfinished {
+			left[season] = true
+		}
+		r.Unfinished = r.Unfinished[:0]
+		for _, season := range seasons {
+			if left[season] {
+				r.Unfinished = append(r.Unfinished, season)
+			}
+		}
+	})
+}
+
+func (t *tally) episodeFailed(season, episode string, err error) {
+	t.update(func(r *Result) {
+		r.Failed++
+		r.Errors = append(r.Errors, fmt.Sprintf("season %s episode %s: %v", season, episode, err))
+	})
+}
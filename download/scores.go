@@ -0,0 +1,51 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+const scoresPathTemplate = "/showscores.php?game_id=%s"
+
+// folder of a season folder that Options.Scores saves the scores pages to,
+// one per episode named like the episode's own file
+const ScoresDir = "scores"
+
+// returns where the scores page of an episode in seasonFolder is saved
+func ScoresFile(seasonFolder, episode string) string {
+	return filepath.Join(seasonFolder, ScoresDir, episode+".html")
+}
+
+// with Options.Scores, saves the episode's showscores.php page next to it
+// unless it is already there and no refresh is due. Failures are logged and
+// listed in the Result, but don't count as failed episodes: the game itself
+// is in the archive.
+func (d *Downloader) downloadScores(ctx context.Context, season string, ep episode, res *tally) {
+	if !d.opts.Scores || d.opts.OnBody != nil {
+		return
+	}
+	file := ScoresFile(filepath.Dir(ep.File), ep.Episode)
+	url := d.url(scoresPathTemplate, ep.GameID)
+	if info, err := os.Stat(file); err == nil && info.Size() > 0 && !d.needsRefresh(ctx, url, info) {
+		return
+	}
+	slog.Debug("downloading scores", "season", season, "epNum", ep.Episode, "url", url)
+	err := os.MkdirAll(filepath.Dir(file), os.ModePerm)
+	if err == nil {
+		var body []byte
+		if body, err = d.fetchGamePage(ctx, url); err == nil {
+			err = writeAtomic(file, body)
+		}
+	}
+	if err != nil {
+		slog.Error("error downloading scores", "season", season, "epNum", ep.Episode, "url", url, "err", err)
+		res.update(func(r *Result) {
+			r.Errors = append(r.Errors, fmt.Sprintf("season %s episode %s scores: %v", season, ep.Episode, err))
+		})
+		return
+	}
+	res.update(func(r *Result) { r.Scores++ })
+}
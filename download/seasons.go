@@ -0,0 +1,55 @@
+package download
+
+import (
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// the "season <id>" folders of an archive directory
+var seasonDirRe = regexp.MustCompile(`^season ([A-Za-z0-9]+)$`)
+
+// returns the season a folder of the archive directory holds, from its
+// "season <id>" name; ok is false for other names
+func SeasonOfDir(name string) (season string, ok bool) {
+	m := seasonDirRe.FindStringSubmatch(name)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
+
+// returns the seasons with a folder in the archive directory, in
+// SeasonLess order
+func SeasonDirs(archiveDir string) ([]string, error) {
+	entries, err := os.ReadDir(archiveDir)
+	if err != nil {
+		return nil, err
+	}
+	var seasons []string
+	for _, e := range entries {
+		if season, ok := SeasonOfDir(e.Name()); ok && e.IsDir() {
+			seasons = append(seasons, season)
+		}
+	}
+	sort.Slice(seasons, func(i, j int) bool { return SeasonLess(seasons[i], seasons[j]) })
+	return seasons, nil
+}
+
+// reports whether season a sorts before b: numbered seasons in numeric
+// order ahead of named seasons in name order. Episode numbers and player
+// ids sort the same way.
+func SeasonLess(a, b string) bool {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return na < nb
+	case errA == nil:
+		return true
+	case errB == nil:
+		return false
+	}
+	return a < b
+}
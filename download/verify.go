@@ -0,0 +1,171 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Audit compares the episodes season pages list with the archive, to find
+// gaps before a parse does
+type Audit struct {
+	Seasons []SeasonAudit
+}
+
+// SeasonAudit is the audit of one season
+type SeasonAudit struct {
+	Season string
+	// episode links on the season page
+	Listed int
+	// listed episodes with a file in the archive
+	Present int
+	// listed episodes whose file is missing or empty, oldest first
+	Missing []PlannedEpisode
+	// listed episodes without a file because J! Archive served something
+	// other than a game page the last time, as the manifest records
+	Rejected []PlannedEpisode
+	// episode files in the season folder that the season page doesn't list
+	Unlisted []string
+	// set when the season page couldn't be read
+	Err error
+}
+
+// fetches the season pages and checks every episode they list against the
+// archive. With no seasons, every season folder in the archive is checked.
+// No episodes are downloaded; season pages are read through the cache.
+func (d *Downloader) Verify(seasons []string) (*Audit, error) {
+	if len(seasons) == 0 {
+		var err error
+		if seasons, err = archivedSeasons(d.opts.ArchiveDir); err != nil {
+			return nil, err
+		}
+	}
+	manifest, err := loadManifest(d.opts.ArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+	audit := &Audit{}
+	for _, season := range seasons {
+		audit.Seasons = append(audit.Seasons, d.verifySeason(season, manifest))
+	}
+	return audit, nil
+}
+
+func (d *Downloader) verifySeason(season string, manifest *Manifest) SeasonAudit {
+	sa := SeasonAudit{Season: season}
+	seasonFolder := filepath.Join(d.opts.ArchiveDir, fmt.Sprintf("season %s", season))
+	episodes, listed, err := d.seasonEpisodes(context.Background(), season, seasonFolder, true)
+	sa.Listed, sa.Err = listed, err
+	if err != nil {
+		return sa
+	}
+	listedFiles := make(map[string]bool)
+	for _, ep := range episodes {
+		listedFiles[filepath.Base(ep.File)] = true
+		if info, err := os.Stat(ep.File); err == nil && info.Size() > 0 {
+			sa.Present++
+			continue
+		}
+		pe := PlannedEpisode{Episode: ep.Episode, GameID: ep.GameID, URL: ep.URL, File: ep.File}
+		if manifest.entries[ep.GameID].Status == statusRejected {
+			sa.Rejected = append(sa.Rejected, pe)
+		} else {
+			sa.Missing = append(sa.Missing, pe)
+		}
+	}
+	files, _ := filepath.Glob(filepath.Join(seasonFolder, "*.html"))
+	for _, f := range files {
+		if !listedFiles[filepath.Base(f)] {
+			sa.Unlisted = append(sa.Unlisted, f)
+		}
+	}
+	sort.Slice(sa.Unlisted, func(i, j int) bool {
+		return SeasonLess(strings.TrimSuffix(filepath.Base(sa.Unlisted[i]), ".html"), strings.TrimSuffix(filepath.Base(sa.Unlisted[j]), ".html"))
+	})
+	return sa
+}
+
+// returns the seasons with a folder in the archive
+func archivedSeasons(dir string) ([]string, error) {
+	seasons, err := SeasonDirs(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive %s: %v", dir, err)
+	}
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("no season folders in %s", dir)
+	}
+	return seasons, nil
+}
+
+// number of listed episodes missing from the archive, rejected ones not
+// included
+func (a *Audit) Missing() int {
+	n := 0
+	for _, s := range a.Seasons {
+		n += len(s.Missing)
+	}
+	return n
+}
+
+// seasons whose page couldn't be read
+func (a *Audit) Failed() int {
+	n := 0
+	for _, s := range a.Seasons {
+		if s.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// seasons with missing episodes, in audit order
+func (a *Audit) Incomplete() []string {
+	var seasons []string
+	for _, s := range a.Seasons {
+		if len(s.Missing) > 0 {
+			seasons = append(seasons, s.Season)
+		}
+	}
+	return seasons
+}
+
+// prints a line per season, every gap under it and the totals
+func (a *Audit) Write(w io.Writer) {
+	rejected := 0
+	for _, s := range a.Seasons {
+		if s.Err != nil {
+			fmt.Fprintf(w, "season %s: error reading season page: %v\n", s.Season, s.Err)
+			continue
+		}
+		rejected += len(s.Rejected)
+		fmt.Fprintf(w, "season %s: %d of %d episodes present", s.Season, s.Present, s.Listed)
+		if len(s.Missing) > 0 {
+			fmt.Fprintf(w, ", %d missing", len(s.Missing))
+		}
+		if len(s.Rejected) > 0 {
+			fmt.Fprintf(w, ", %d rejected", len(s.Rejected))
+		}
+		if len(s.Unlisted) > 0 {
+			fmt.Fprintf(w, ", %d not listed", len(s.Unlisted))
+		}
+		fmt.Fprintln(w)
+		for _, ep := range s.Missing {
+			fmt.Fprintf(w, "  missing  #%s %s -> %s\n", ep.Episode, ep.URL, ep.File)
+		}
+		for _, ep := range s.Rejected {
+			fmt.Fprintf(w, "  rejected #%s %s\n", ep.Episode, ep.URL)
+		}
+		for _, f := range s.Unlisted {
+			fmt.Fprintf(w, "  unlisted %s\n", f)
+		}
+	}
+	fmt.Fprintf(w, "%d episodes missing, %d rejected in %d seasons", a.Missing(), rejected, len(a.Seasons))
+	if n := a.Failed(); n > 0 {
+		fmt.Fprintf(w, "; %d season pages couldn't be read", n)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,241 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+// rows per Arrow record batch, so memory stays bounded on the full archive
+const arrowBatchRows = 64 * 1024
+
+// the columns of the parse CSVs as Arrow types. Numbers the CSVs leave
+// empty, such as Final Jeopardy's value and board position, are null, as
+// are missing air dates.
+var arrowSchema = arrow.NewSchema([]arrow.Field{
+	{Name: "clue_id", Type: arrow.BinaryTypes.String},
+	{Name: "season", Type: arrow.BinaryTypes.String},
+	{Name: "game_id", Type: arrow.BinaryTypes.String},
+	{Name: "epNum", Type: arrow.BinaryTypes.String},
+	{Name: "airDate", Type: arrow.FixedWidthTypes.Date32, Nullable: true},
+	{Name: "round_name", Type: arrow.BinaryTypes.String},
+	{Name: "category", Type: arrow.BinaryTypes.String},
+	{Name: "value", Type: arrow.PrimitiveTypes.Int32, Nullable: true},
+	{Name: "value_raw", Type: arrow.BinaryTypes.String},
+	{Name: "daily_double", Type: arrow.FixedWidthTypes.Boolean},
+	{Name: "board_column", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+	{Name: "board_row", Type: arrow.PrimitiveTypes.Int8, Nullable: true},
+	{Name: "question", Type: arrow.BinaryTypes.String},
+	{Name: "clue_notes", Type: arrow.BinaryTypes.String},
+	{Name: "answer", Type: arrow.BinaryTypes.String},
+	{Name: "answer_normalized", Type: arrow.BinaryTypes.String},
+	{Name: "triple_stumper", Type: arrow.FixedWidthTypes.Boolean},
+	{Name: "tournament", Type: arrow.BinaryTypes.String},
+	{Name: "tournament_stage", Type: arrow.BinaryTypes.String},
+	{Name: "tournament_game", Type: arrow.PrimitiveTypes.Int16, Nullable: true},
+	{Name: "host", Type: arrow.BinaryTypes.String},
+	{Name: "game_format", Type: arrow.BinaryTypes.String},
+	{Name: "revealed", Type: arrow.FixedWidthTypes.Boolean},
+}, func() *arrow.Metadata {
+	md := arrow.NewMetadata([]string{"jarchive.schema_version"}, []string{strconv.Itoa(parse.SchemaVersion)})
+	return &md
+}())
+
+// writes clues as an Arrow IPC file (Feather v2), one typed column per
+// parse CSV column plus revealed
+func WriteArrow(w io.Writer, clues []dataset.Clue) error {
+	mem := memory.NewGoAllocator()
+	fw, err := ipc.NewFileWriter(w, ipc.WithSchema(arrowSchema), ipc.WithAllocator(mem))
+	if err != nil {
+		return err
+	}
+	if err := arrowRecords(mem, clues, func(rec arrow.Record) error { return fw.Write(rec) }); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// builds clues into records of arrowSchema, arrowBatchRows at a time, and
+// passes each to write
+func arrowRecords(mem memory.Allocator, clues []dataset.Clue, write func(arrow.Record) error) error {
+	b := array.NewRecordBuilder(mem, arrowSchema)
+	defer b.Release()
+	for start := 0; start < len(clues); start += arrowBatchRows {
+		end := min(start+arrowBatchRows, len(clues))
+		for i := range clues[start:end] {
+			if err := appendArrow(b, &clues[start+i]); err != nil {
+				return err
+			}
+		}
+		rec := b.NewRecord()
+		err := write(rec)
+		rec.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reads the clues of an Arrow IPC file as WriteArrow writes it
+func ReadArrow(r ipc.ReadAtSeeker) ([]dataset.Clue, error) {
+	fr, err := ipc.NewFileReader(r, ipc.WithAllocator(memory.NewGoAllocator()))
+	if err != nil {
+		return nil, err
+	}
+	defer fr.Close()
+	var clues []dataset.Clue
+	for i := range fr.NumRecords() {
+		rec, err := fr.Record(i)
+		if err != nil {
+			return nil, err
+		}
+		if clues, err = appendRecord(clues, rec); err != nil {
+			return nil, err
+		}
+	}
+	return clues, nil
+}
+
+// appends one clue to the record being built, in arrowSchema's order
+func appendArrow(b *array.RecordBuilder, c *dataset.Clue) error {
+	str := func(i int, s string) { b.Field(i).(*array.StringBuilder).Append(s) }
+	flag := func(i int, v bool) { b.Field(i).(*array.BooleanBuilder).Append(v) }
+
+	str(0, c.ID())
+	str(1, c.Season)
+	str(2, c.GameID)
+	str(3, c.EpisodeNumber)
+	date := b.Field(4).(*array.Date32Builder)
+	if c.AirDate == "" {
+		date.AppendNull()
+	} else {
+		t, err := time.Parse(time.DateOnly, c.AirDate)
+		if err != nil {
+			return fmt.Errorf("season %s show %s: invalid air date %q", c.Season, c.EpisodeNumber, c.AirDate)
+		}
+		date.Append(arrow.Date32FromTime(t))
+	}
+	str(5, c.Round)
+	str(6, c.Category)
+	if value := b.Field(7).(*array.Int32Builder); c.Value == 0 {
+		value.AppendNull()
+	} else {
+		value.Append(int32(c.Value))
+	}
+	str(8, c.ValueRaw)
+	flag(9, c.DailyDouble)
+	position(b.Field(10).(*array.Int8Builder), c.Column)
+	position(b.Field(11).(*array.Int8Builder), c.Row)
+	str(12, c.Question)
+	str(13, c.Notes)
+	str(14, c.Answer)
+	str(15, jarchive.NormalizeAnswer(c.Answer))
+	flag(16, c.TripleStumper)
+	str(17, c.Tournament)
+	str(18, c.TournamentStage)
+	if game := b.Field(19).(*array.Int16Builder); c.TournamentGame == 0 {
+		game.AppendNull()
+	} else {
+		game.Append(int16(c.TournamentGame))
+	}
+	str(20, c.Host)
+	str(21, c.Format)
+	flag(22, c.Revealed)
+	return nil
+}
+
+// appends a board column or row, null when the clue has none
+func position(b *array.Int8Builder, n int) {
+	if n == 0 {
+		b.AppendNull()
+	} else {
+		b.Append(int8(n))
+	}
+}
+
+// appends the clues of a record with arrowSchema's columns, found by name
+// so files written before a column was added still read; a missing
+// revealed column means every clue was
+func appendRecord(clues []dataset.Clue, rec arrow.Record) ([]dataset.Clue, error) {
+	cols := make(map[string]arrow.Array)
+	for i, f := range rec.Schema().Fields() {
+		cols[f.Name] = rec.Column(i)
+	}
+	if cols["season"] == nil || cols["round_name"] == nil {
+		return nil, fmt.Errorf("no season and round_name columns; not clues as jarchive exports them")
+	}
+	str := func(name string, i int) string {
+		if a, ok := cols[name].(interface{ Value(int) string }); ok && cols[name].IsValid(i) {
+			return a.Value(i)
+		}
+		return ""
+	}
+	num := func(name string, i int) int {
+		if a := cols[name]; a == nil || a.IsNull(i) {
+			return 0
+		}
+		switch a := cols[name].(type) {
+		case *array.Int8:
+			return int(a.Value(i))
+		case *array.Int16:
+			return int(a.Value(i))
+		case *array.Int32:
+			return int(a.Value(i))
+		case *array.Int64:
+			return int(a.Value(i))
+		}
+		return 0
+	}
+	flag := func(name string, i int, missing bool) bool {
+		a, ok := cols[name].(*array.Boolean)
+		if !ok {
+			return missing
+		}
+		return a.IsValid(i) && a.Value(i)
+	}
+	for i := range int(rec.NumRows()) {
+		c := dataset.Clue{
+			Season:          str("season", i),
+			GameID:          str("game_id", i),
+			EpisodeNumber:   str("epNum", i),
+			Tournament:      str("tournament", i),
+			TournamentStage: str("tournament_stage", i),
+			TournamentGame:  num("tournament_game", i),
+			Host:            str("host", i),
+			Format:          str("game_format", i),
+			Clue: jarchive.Clue{
+				Round:         str("round_name", i),
+				Category:      str("category", i),
+				Value:         num("value", i),
+				ValueRaw:      str("value_raw", i),
+				DailyDouble:   flag("daily_double", i, false),
+				Question:      str("question", i),
+				Notes:         str("clue_notes", i),
+				Answer:        str("answer", i),
+				Revealed:      flag("revealed", i, true),
+				TripleStumper: flag("triple_stumper", i, false),
+				Column:        num("board_column", i),
+				Row:           num("board_row", i),
+			},
+		}
+		if a, ok := cols["airDate"].(*array.Date32); ok && a.IsValid(i) {
+			c.AirDate = a.Value(i).ToTime().Format(time.DateOnly)
+		} else {
+			c.AirDate = str("airDate", i)
+		}
+		clues = append(clues, c)
+	}
+	return clues, nil
+}
@@ -0,0 +1,78 @@
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/ipc"
+)
+
+// writes the golden clues as an Arrow file and checks that ReadArrow reads
+// every clue back as it was
+func TestArrowRoundTrip(t *testing.T) {
+	clues := goldenClues(t)
+	var buf bytes.Buffer
+	if err := WriteArrow(&buf, clues); err != nil {
+		t.Fatalf("WriteArrow: %v", err)
+	}
+	got, err := ReadArrow(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadArrow: %v", err)
+	}
+	if len(got) != len(clues) {
+		t.Fatalf("read %d clues, want %d", len(got), len(clues))
+	}
+	for i := range clues {
+		if !reflect.DeepEqual(got[i], clues[i]) {
+			t.Fatalf("clue %d: read %+v, want %+v", i, got[i], clues[i])
+		}
+	}
+}
+
+// checks the typed columns: clue_id, a Date32 air date and nulls where the
+// CSVs leave numbers and dates empty
+func TestArrowColumns(t *testing.T) {
+	clues := goldenClues(t)
+	var buf bytes.Buffer
+	if err := WriteArrow(&buf, clues); err != nil {
+		t.Fatalf("WriteArrow: %v", err)
+	}
+	fr, err := ipc.NewFileReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fr.Close()
+	if !fr.Schema().Equal(arrowSchema) {
+		t.Errorf("got schema %s, want %s", fr.Schema(), arrowSchema)
+	}
+	rec, err := fr.Record(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	column := func(name string) arrow.Array {
+		idx := rec.Schema().FieldIndices(name)
+		if len(idx) != 1 {
+			t.Fatalf("no %s column", name)
+		}
+		return rec.Column(idx[0])
+	}
+	ids := column("clue_id").(*array.String)
+	airDates := column("airDate").(*array.Date32)
+	values := column("value").(*array.Int32)
+	rows := column("board_row").(*array.Int8)
+	for i := range clues {
+		c := &clues[i]
+		if ids.Value(i) != c.ID() {
+			t.Errorf("clue %d: clue_id %q, want %q", i, ids.Value(i), c.ID())
+		}
+		if c.AirDate == "" && airDates.IsValid(i) || c.AirDate != "" && airDates.IsNull(i) {
+			t.Errorf("clue %d: airDate null is %t for %q", i, airDates.IsNull(i), c.AirDate)
+		}
+		if (c.Value == 0) != values.IsNull(i) || (c.Row == 0) != rows.IsNull(i) {
+			t.Errorf("clue %d: value and board_row nulls are %t, %t for %d, %d", i, values.IsNull(i), rows.IsNull(i), c.Value, c.Row)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package export
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"j-parser-go/dataset"
+)
+
+// turns the tabs and line breaks that would split a card into spaces
+var cardReplacer = strings.NewReplacer("\t", " ", "\r\n", " ", "\n", " ", "\r", " ")
+
+// writes clues as cloze flashcards, one per line: the card's text, then a
+// tab and its space-separated tags. The text is the category and clue,
+// with the correct response as a {{c1::...}} cloze deletion, e.g.
+//
+//	FILM: This 1942 film features the line "Here's looking at you, kid" — {{c1::Casablanca}}
+//
+// which Anki, RemNote, Mochi and other spaced-repetition tools show as the
+// clue on the front and the response on the back. The tags are
+// season::40, round::double-jeopardy, either value::1600 or, for Daily
+// Doubles, daily-double, and clue:: with the clue's stable ID. Unrevealed
+// clues are left out.
+func WriteCloze(w io.Writer, clues []dataset.Clue) error {
+	bw := bufio.NewWriter(w)
+	for i := range clues {
+		c := &clues[i]
+		if !c.Revealed || c.Question == "" || c.Answer == "" {
+			continue
+		}
+		text := c.Question + " — {{c1::" + c.Answer + "}}"
+		if c.Category != "" {
+			text = c.Category + ": " + text
+		}
+		bw.WriteString(cardReplacer.Replace(text))
+		bw.WriteByte('\t')
+		bw.WriteString(strings.Join(clozeTags(c), " "))
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// returns a card's tags, "::" separating a tag's group from its value so
+// tools with nested tags file them under season, round and value
+func clozeTags(c *dataset.Clue) []string {
+	tags := []string{"season::" + clozeTag(c.Season), "round::" + clozeTag(c.Round)}
+	switch {
+	case c.DailyDouble:
+		tags = append(tags, "daily-double")
+	case c.Value != 0:
+		tags = append(tags, "value::"+strconv.Itoa(c.Value))
+	}
+	return append(tags, "clue::"+c.ID())
+}
+
+// lowercases s and joins its words with dashes, as a tag can't hold spaces
+func clozeTag(s string) string {
+	return strings.Join(strings.Fields(strings.ToLower(s)), "-")
+}
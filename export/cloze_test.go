@@ -0,0 +1,18 @@
+package export
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// compares the golden clues' cloze flashcards with testdata/cloze.golden.txt
+func TestGoldenCloze(t *testing.T) {
+	var got bytes.Buffer
+	if err := WriteCloze(&got, goldenClues(t)); err != nil {
+		t.Fatal(err)
+	}
+	golden.Compare(t, filepath.Join("testdata", "cloze.golden.txt"), got.Bytes())
+}
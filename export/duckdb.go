@@ -0,0 +1,155 @@
+//go:build duckdb
+
+package export
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/duckdb/duckdb-go/v2"
+
+	"j-parser-go/dataset"
+)
+
+// the tables, with the clues_with_games view putting each clue back
+// together with its game, round and category
+const duckDBSchema = `
+CREATE TABLE games (
+	game_id VARCHAR PRIMARY KEY,
+	jarchive_game_id VARCHAR,
+	season VARCHAR NOT NULL,
+	epNum VARCHAR NOT NULL,
+	airDate DATE,
+	tournament VARCHAR,
+	tournament_stage VARCHAR,
+	tournament_game INTEGER,
+	host VARCHAR,
+	game_format VARCHAR
+);
+CREATE TABLE rounds (
+	round_id VARCHAR PRIMARY KEY,
+	game_id VARCHAR NOT NULL REFERENCES games (game_id),
+	round_number INTEGER NOT NULL,
+	round_name VARCHAR NOT NULL
+);
+CREATE TABLE categories (
+	category_id INTEGER PRIMARY KEY,
+	category VARCHAR NOT NULL
+);
+CREATE TABLE clues (
+	clue_id VARCHAR PRIMARY KEY,
+	game_id VARCHAR NOT NULL REFERENCES games (game_id),
+	round_id VARCHAR NOT NULL REFERENCES rounds (round_id),
+	category_id INTEGER NOT NULL REFERENCES categories (category_id),
+	value INTEGER,
+	value_raw VARCHAR,
+	daily_double BOOLEAN NOT NULL,
+	board_column INTEGER,
+	board_row INTEGER,
+	question VARCHAR,
+	clue_notes VARCHAR,
+	answer VARCHAR,
+	triple_stumper BOOLEAN NOT NULL,
+	revealed BOOLEAN NOT NULL
+);
+CREATE VIEW clues_with_games AS
+SELECT c.clue_id, g.game_id, g.jarchive_game_id, g.season, g.epNum, g.airDate,
+	r.round_name, cat.category, c.value, c.value_raw, c.daily_double, c.board_column, c.board_row,
+	c.question, c.clue_notes, c.answer, c.triple_stumper, c.revealed,
+	g.tournament, g.tournament_stage, g.tournament_game, g.host, g.game_format
+FROM clues c
+JOIN games g USING (game_id)
+JOIN rounds r USING (round_id)
+JOIN categories cat USING (category_id);
+`
+
+// writes clues to a new DuckDB database at path as the normalized tables,
+// replacing the file once it's complete
+func WriteDuckDB(path string, clues []dataset.Clue) error {
+	partial := path + ".partial"
+	os.Remove(partial)
+	if err := writeDuckDB(partial, Normalize(clues)); err != nil {
+		os.Remove(partial)
+		os.Remove(partial + ".wal")
+		return err
+	}
+	return os.Rename(partial, path)
+}
+
+func writeDuckDB(path string, t *Tables) error {
+	db, err := sql.Open("duckdb", path)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+	if _, err := db.ExecContext(ctx, duckDBSchema); err != nil {
+		return fmt.Errorf("error creating tables: %v", err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	err = conn.Raw(func(dc any) error {
+		return errors.Join(
+			appendRows(dc.(driver.Conn), "games", len(t.Games), func(i int) []driver.Value {
+				g := &t.Games[i]
+				return []driver.Value{g.ID, orNull(g.JArchiveID), g.Season, g.EpisodeNumber, date(g.AirDate),
+					orNull(g.Tournament), orNull(g.TournamentStage), orNull(g.TournamentGame), orNull(g.Host), g.Format}
+			}),
+			appendRows(dc.(driver.Conn), "rounds", len(t.Rounds), func(i int) []driver.Value {
+				r := &t.Rounds[i]
+				return []driver.Value{r.ID, r.GameID, r.Number, r.Name}
+			}),
+			appendRows(dc.(driver.Conn), "categories", len(t.Categories), func(i int) []driver.Value {
+				return []driver.Value{t.Categories[i].ID, t.Categories[i].Name}
+			}),
+			appendRows(dc.(driver.Conn), "clues", len(t.Clues), func(i int) []driver.Value {
+				c := &t.Clues[i]
+				return []driver.Value{c.ID, c.GameID, c.RoundID, c.CategoryID, orNull(c.Value), c.ValueRaw,
+					c.DailyDouble, orNull(c.Column), orNull(c.Row), c.Question, c.Notes, c.Answer, c.TripleStumper, c.Revealed}
+			}),
+		)
+	})
+	if err != nil {
+		return err
+	}
+	if err := conn.Close(); err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// appends n rows to table, in the order rows(i) returns them
+func appendRows(conn driver.Conn, table string, n int, row func(i int) []driver.Value) error {
+	a, err := duckdb.NewAppenderFromConn(conn, "", table)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %v", table, err)
+	}
+	for i := range n {
+		if err := a.AppendRow(row(i)...); err != nil {
+			a.Close()
+			return fmt.Errorf("error writing %s: %v", table, err)
+		}
+	}
+	if err := a.Close(); err != nil {
+		return fmt.Errorf("error writing %s: %v", table, err)
+	}
+	return nil
+}
+
+// returns a YYYY-MM-DD air date as a DATE, NULL if it's missing or invalid
+func date(airDate string) driver.Value {
+	t, err := time.Parse(time.DateOnly, airDate)
+	if err != nil {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,14 @@
+//go:build !duckdb
+
+package export
+
+import (
+	"errors"
+
+	"j-parser-go/dataset"
+)
+
+// without -tags duckdb there's no DuckDB driver to write with
+func WriteDuckDB(path string, clues []dataset.Clue) error {
+	return errors.New("this binary was built without DuckDB support; rebuild it with go build -tags duckdb")
+}
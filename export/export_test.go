@@ -0,0 +1,19 @@
+package export
+
+import (
+	"testing"
+
+	"j-parser-go/dataset"
+	"j-parser-go/internal/golden"
+)
+
+// loads each of the parse package's golden CSVs as a season, in
+// dataset.Load's order
+func goldenClues(t *testing.T) []dataset.Clue {
+	t.Helper()
+	clues, err := dataset.Load(dataset.Options{Dir: golden.Seasons(t)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return clues
+}
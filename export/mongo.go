@@ -0,0 +1,100 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+	"go.mongodb.org/mongo-driver/v2/x/mongo/driver/connstring"
+
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+const (
+	// games per bulk write
+	mongoBatchGames = 500
+	// the database used when the URI doesn't name one
+	mongoDefaultDatabase = "jarchive"
+)
+
+// mongoGame is a game document: the game as serve's /games/{id} returns it,
+// its clues with their IDs, keyed on its season and show number
+type mongoGame struct {
+	ID     mongoGameID `json:"_id"`
+	Season string
+	*jarchive.Game
+	Rounds []jarchive.IdentifiedRound
+}
+
+type mongoGameID struct {
+	Season        string
+	EpisodeNumber string
+}
+
+// upserts one document per game into collection of the MongoDB database
+// uri names ("jarchive" if it names none), replacing the documents of games
+// exported before
+func WriteMongo(uri, collection string, seasons []parse.SeasonGames) error {
+	cs, err := connstring.Parse(uri)
+	if err != nil {
+		return err
+	}
+	database := cs.Database
+	if database == "" {
+		database = mongoDefaultDatabase
+	}
+	client, err := mongo.Connect(options.Client().ApplyURI(uri))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	defer client.Disconnect(ctx)
+	coll := client.Database(database).Collection(collection)
+
+	var batch []mongo.WriteModel
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		_, err := coll.BulkWrite(ctx, batch, options.BulkWrite().SetOrdered(false))
+		batch = batch[:0]
+		if err != nil {
+			return fmt.Errorf("error writing to %s.%s: %v", database, collection, err)
+		}
+		return nil
+	}
+	for _, s := range seasons {
+		for _, g := range s.Games {
+			doc, err := mongoDocument(s.Season, g)
+			if err != nil {
+				return err
+			}
+			batch = append(batch, mongo.NewReplaceOneModel().
+				SetFilter(bson.D{{Key: "_id", Value: doc[0].Value}}).SetReplacement(doc).SetUpsert(true))
+			if len(batch) == mongoBatchGames {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return flush()
+}
+
+// returns a game's document with the fields named and nested as in its
+// JSON, _id first
+func mongoDocument(season string, g *jarchive.Game) (bson.D, error) {
+	data, err := json.Marshal(mongoGame{mongoGameID{season, g.EpisodeNumber}, season, g, g.IdentifiedRounds(season)})
+	if err != nil {
+		return nil, err
+	}
+	var doc bson.D
+	if err := bson.UnmarshalExtJSON(data, false, &doc); err != nil {
+		return nil, fmt.Errorf("season %s show %s: %v", season, g.EpisodeNumber, err)
+	}
+	return doc, nil
+}
@@ -0,0 +1,46 @@
+package export
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"j-parser-go/internal/golden"
+	"j-parser-go/jarchive"
+)
+
+// turns each jarchive fixture into its game document, as a season of its
+// own, and compares the document as canonical extended JSON with
+// testdata/mongo/<fixture>.golden.json
+func TestGoldenMongo(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in ../jarchive/testdata")
+	}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			game, err := jarchive.ParseFile(fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc, err := mongoDocument(name, game)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc[0].Key != "_id" {
+				t.Errorf("document starts with %s, not _id", doc[0].Key)
+			}
+			got, err := bson.MarshalExtJSONIndent(doc, true, false, "", "\t")
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "mongo", name+".golden.json"), append(got, '\n'))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	_ "github.com/go-sql-driver/mysql"
+
+	"j-parser-go/dataset"
+)
+
+// rows per INSERT statement, well under MySQL's 65535 placeholders
+const mysqlBatchRows = 500
+
+// games keyed on season and show number, clues on their game and place on
+// the board, so exporting again updates rows instead of duplicating them.
+// board_column and board_row are 0 for clues off the board, as key columns
+// can't be NULL.
+var mysqlSchema = []string{`
+CREATE TABLE IF NOT EXISTS games (
+	season VARCHAR(32) NOT NULL,
+	epNum VARCHAR(16) NOT NULL,
+	jarchive_game_id VARCHAR(16) NULL,
+	airDate DATE NULL,
+	tournament VARCHAR(255) NULL,
+	tournament_stage VARCHAR(32) NULL,
+	tournament_game SMALLINT NULL,
+	host VARCHAR(255) NULL,
+	game_format VARCHAR(16) NOT NULL,
+	PRIMARY KEY (season, epNum)
+) DEFAULT CHARSET = utf8mb4`, `
+CREATE TABLE IF NOT EXISTS clues (
+	clue_id CHAR(16) NOT NULL,
+	season VARCHAR(32) NOT NULL,
+	epNum VARCHAR(16) NOT NULL,
+	round_name VARCHAR(32) NOT NULL,
+	board_column TINYINT NOT NULL,
+	board_row TINYINT NOT NULL,
+	category VARCHAR(255) NOT NULL,
+	value INT NULL,
+	value_raw VARCHAR(32) NOT NULL,
+	daily_double BOOLEAN NOT NULL,
+	question TEXT NOT NULL,
+	clue_notes TEXT NOT NULL,
+	answer TEXT NOT NULL,
+	triple_stumper BOOLEAN NOT NULL,
+	revealed BOOLEAN NOT NULL,
+	PRIMARY KEY (season, epNum, round_name, board_column, board_row),
+	UNIQUE KEY (clue_id),
+	FOREIGN KEY (season, epNum) REFERENCES games (season, epNum)
+) DEFAULT CHARSET = utf8mb4`}
+
+var (
+	mysqlGameColumns = []string{"season", "epNum", "jarchive_game_id", "airDate", "tournament", "tournament_stage", "tournament_game", "host", "game_format"}
+	mysqlClueColumns = []string{"season", "epNum", "round_name", "board_column", "board_row", "clue_id", "category", "value", "value_raw", "daily_double", "question", "clue_notes", "answer", "triple_stumper", "revealed"}
+)
+
+// writes clues to the MySQL or MariaDB database dsn names (in the driver's
+// user:password@tcp(host:3306)/database form), creating the games and clues
+// tables if they don't exist and replacing rows already there, all in one
+// transaction
+func WriteMySQL(dsn string, clues []dataset.Clue) error {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return writeMySQL(db, clues)
+}
+
+// WriteMySQL's work on an open database, which the tests give a driver that
+// records the statements
+func writeMySQL(db *sql.DB, clues []dataset.Clue) error {
+	for _, stmt := range mysqlSchema {
+		if _, err := db.Exec(stmt); err != nil {
+			return fmt.Errorf("error creating tables: %v", err)
+		}
+	}
+
+	t := Normalize(clues)
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	err = upsert(tx, "games", mysqlGameColumns, 2, len(t.Games), func(i int) []any {
+		g := &t.Games[i]
+		return []any{g.Season, g.EpisodeNumber, orNull(g.JArchiveID), orNull(g.AirDate),
+			orNull(g.Tournament), orNull(g.TournamentStage), orNull(g.TournamentGame), orNull(g.Host), g.Format}
+	})
+	if err != nil {
+		return err
+	}
+	err = upsert(tx, "clues", mysqlClueColumns, 5, len(t.Clues), func(i int) []any {
+		c := t.Clues[i].Clue
+		return []any{c.Season, c.EpisodeNumber, c.Round, c.Column, c.Row, t.Clues[i].ID, c.Category, orNull(c.Value), c.ValueRaw,
+			c.DailyDouble, c.Question, c.Notes, c.Answer, c.TripleStumper, c.Revealed}
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// inserts n rows into table in batches, updating the rows whose first keys
+// columns match one already there
+func upsert(tx *sql.Tx, table string, columns []string, keys, n int, row func(i int) []any) error {
+	row1 := "(" + strings.Repeat("?, ", len(columns)-1) + "?)"
+	updates := make([]string, 0, len(columns)-keys)
+	for _, col := range columns[keys:] {
+		// VALUES() rather than a row alias, which MariaDB doesn't have
+		updates = append(updates, fmt.Sprintf("%s = VALUES(%s)", col, col))
+	}
+	for start := 0; start < n; start += mysqlBatchRows {
+		end := min(start+mysqlBatchRows, n)
+		args := make([]any, 0, (end-start)*len(columns))
+		for i := start; i < end; i++ {
+			args = append(args, row(i)...)
+		}
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s", table,
+			strings.Join(columns, ", "), strings.TrimSuffix(strings.Repeat(row1+", ", end-start), ", "), strings.Join(updates, ", "))
+		if _, err := tx.Exec(query, args...); err != nil {
+			return fmt.Errorf("error writing %s: %v", table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package export
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// a database/sql driver that writes down the statements it's given instead
+// of running them
+type recordingDriver struct {
+	mu  sync.Mutex
+	log bytes.Buffer
+}
+
+func (d *recordingDriver) Open(string) (driver.Conn, error) { return recordingConn{d}, nil }
+
+func (d *recordingDriver) record(format string, args ...any) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	fmt.Fprintf(&d.log, format, args...)
+}
+
+type recordingConn struct{ d *recordingDriver }
+
+func (c recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("recordingConn: Prepare not supported")
+}
+
+func (c recordingConn) Close() error { return nil }
+
+func (c recordingConn) Begin() (driver.Tx, error) {
+	c.d.record("BEGIN\n")
+	return recordingTx(c), nil
+}
+
+// writes the query and then its arguments, one row of VALUES to a line
+func (c recordingConn) Exec(query string, args []driver.Value) (driver.Result, error) {
+	c.d.record("%s\n", strings.TrimSpace(query))
+	rows := 1
+	if strings.Contains(query, " VALUES (") {
+		rows = strings.Count(query, "), (") + 1
+	}
+	if len(args) > 0 {
+		per := len(args) / rows
+		for i := 0; i < len(args); i += per {
+			vals := make([]string, per)
+			for j, v := range args[i : i+per] {
+				if v == nil {
+					vals[j] = "NULL"
+				} else {
+					vals[j] = fmt.Sprintf("%#v", v)
+				}
+			}
+			c.d.record("\t%s\n", strings.Join(vals, ", "))
+		}
+	}
+	return driver.RowsAffected(rows), nil
+}
+
+type recordingTx recordingConn
+
+func (tx recordingTx) Commit() error {
+	tx.d.record("COMMIT\n")
+	return nil
+}
+
+func (tx recordingTx) Rollback() error {
+	tx.d.record("ROLLBACK\n")
+	return nil
+}
+
+// numbers the registered drivers, as sql.Register refuses a name twice
+var recordingDrivers atomic.Int32
+
+// a database whose statements end up in the returned driver's log
+func recordingDB(t *testing.T) (*sql.DB, *recordingDriver) {
+	t.Helper()
+	d := &recordingDriver{}
+	name := fmt.Sprintf("recording-%d", recordingDrivers.Add(1))
+	sql.Register(name, d)
+	db, err := sql.Open(name, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, d
+}
+
+// compares the statements and arguments WriteMySQL sends for the golden
+// clues with testdata/mysql.golden.sql
+func TestGoldenMySQL(t *testing.T) {
+	db, d := recordingDB(t)
+	if err := writeMySQL(db, goldenClues(t)); err != nil {
+		t.Fatal(err)
+	}
+	golden.Compare(t, filepath.Join("testdata", "mysql.golden.sql"), d.log.Bytes())
+}
+
+// checks that more clues than fit in one statement are split into batches
+// of mysqlBatchRows, all in the one transaction
+func TestMySQLBatches(t *testing.T) {
+	clues := goldenClues(t)
+	many := clues
+	for len(many) <= 2*mysqlBatchRows {
+		many = append(many, clues...)
+	}
+	db, d := recordingDB(t)
+	if err := writeMySQL(db, many); err != nil {
+		t.Fatal(err)
+	}
+	log := d.log.String()
+	want := (len(many) + mysqlBatchRows - 1) / mysqlBatchRows
+	if got := strings.Count(log, "INSERT INTO clues "); got != want {
+		t.Errorf("got %d clue INSERTs for %d clues, want %d", got, len(many), want)
+	}
+	if strings.Count(log, "BEGIN\n") != 1 || !strings.HasSuffix(log, "COMMIT\n") {
+		t.Errorf("INSERTs aren't in one committed transaction:\n%s", log[:min(len(log), 200)])
+	}
+}
@@ -0,0 +1,51 @@
+package export
+
+import (
+	"context"
+	"io"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/apache/arrow-go/v18/parquet"
+	"github.com/apache/arrow-go/v18/parquet/compress"
+	"github.com/apache/arrow-go/v18/parquet/pqarrow"
+
+	"j-parser-go/dataset"
+)
+
+// writes clues as a Snappy-compressed Parquet file with the columns and
+// types of WriteArrow, a row group per batch. The Arrow schema is stored
+// with it, so readers such as pandas and DuckDB get the same types back.
+func WriteParquet(w io.Writer, clues []dataset.Clue) error {
+	mem := memory.NewGoAllocator()
+	props := parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy), parquet.WithAllocator(mem))
+	// hide any Close method, which the writer would otherwise call on w
+	fw, err := pqarrow.NewFileWriter(arrowSchema, struct{ io.Writer }{w}, props, pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()))
+	if err != nil {
+		return err
+	}
+	if err := arrowRecords(mem, clues, func(rec arrow.Record) error { return fw.Write(rec) }); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// reads the clues of a Parquet file as WriteParquet writes it
+func ReadParquet(r parquet.ReaderAtSeeker) ([]dataset.Clue, error) {
+	tbl, err := pqarrow.ReadTable(context.Background(), r, nil, pqarrow.ArrowReadProperties{}, memory.NewGoAllocator())
+	if err != nil {
+		return nil, err
+	}
+	defer tbl.Release()
+	tr := array.NewTableReader(tbl, arrowBatchRows)
+	defer tr.Release()
+	var clues []dataset.Clue
+	for tr.Next() {
+		if clues, err = appendRecord(clues, tr.Record()); err != nil {
+			return nil, err
+		}
+	}
+	return clues, tr.Err()
+}
@@ -0,0 +1,45 @@
+package export
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"j-parser-go/dataset"
+)
+
+// directions for WriteQuizlet
+const (
+	// the clue is the term and the correct response its definition
+	ClueToResponse = "clue-response"
+	// the correct response is the term and the clue its definition, for
+	// studying the other way round
+	ResponseToClue = "response-clue"
+)
+
+// writes clues in Quizlet's import format, one card per line with the term
+// and definition separated by a tab. direction is ClueToResponse or
+// ResponseToClue; the clue side is the category and clue, e.g. "FILM: This
+// 1942 film features...". Unrevealed clues are left out.
+func WriteQuizlet(w io.Writer, clues []dataset.Clue, direction string) error {
+	if direction != ClueToResponse && direction != ResponseToClue {
+		return fmt.Errorf("unknown direction %q (want %s or %s)", direction, ClueToResponse, ResponseToClue)
+	}
+	bw := bufio.NewWriter(w)
+	for i := range clues {
+		c := &clues[i]
+		if !c.Revealed || c.Question == "" || c.Answer == "" {
+			continue
+		}
+		clue := c.Question
+		if c.Category != "" {
+			clue = c.Category + ": " + clue
+		}
+		term, definition := cardReplacer.Replace(clue), cardReplacer.Replace(c.Answer)
+		if direction == ResponseToClue {
+			term, definition = definition, term
+		}
+		bw.WriteString(term + "\t" + definition + "\n")
+	}
+	return bw.Flush()
+}
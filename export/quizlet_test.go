@@ -0,0 +1,32 @@
+package export
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// compares the golden clues' Quizlet cards, each direction, with
+// testdata/quizlet.<direction>.golden.txt
+func TestGoldenQuizlet(t *testing.T) {
+	clues := goldenClues(t)
+	for _, direction := range []string{ClueToResponse, ResponseToClue} {
+		t.Run(direction, func(t *testing.T) {
+			var got bytes.Buffer
+			if err := WriteQuizlet(&got, clues, direction); err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "quizlet."+direction+".golden.txt"), got.Bytes())
+		})
+	}
+}
+
+// checks that WriteQuizlet refuses a direction it doesn't know
+func TestQuizletDirection(t *testing.T) {
+	if err := WriteQuizlet(io.Discard, nil, "sideways"); err == nil {
+		t.Error("WriteQuizlet accepted the direction sideways")
+	}
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	"j-parser-go/dataset"
+)
+
+// clues per pipeline sent to Redis
+const redisBatchClues = 1000
+
+// loads clues into the Redis database url names, under keys starting with
+// prefix:
+//
+//	<prefix>clue:<id>         a hash of the clue's CSV columns and revealed
+//	<prefix>clues             a set of every clue id
+//	<prefix>category:<name>   a set of the ids of the category's clues
+//	<prefix>categories        a set of every category name
+//	<prefix>airdate           a sorted set of clue ids scored by air date as YYYYMMDD
+//
+// A clue's id is its clue_id, so loading again overwrites the clues
+// already there, however the CSVs order them.
+func WriteRedis(url, prefix string, clues []dataset.Clue) error {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return err
+	}
+	client := redis.NewClient(opts)
+	defer client.Close()
+	ctx := context.Background()
+
+	ids := redisClueIDs(clues)
+	for start := 0; start < len(clues); start += redisBatchClues {
+		end := min(start+redisBatchClues, len(clues))
+		_, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+			for i := start; i < end; i++ {
+				c, id := &clues[i], ids[i]
+				fields := make(map[string]any, len(dataset.Header)+1)
+				for j, v := range c.Record() {
+					fields[dataset.Header[j]] = v
+				}
+				fields["revealed"] = strconv.FormatBool(c.Revealed)
+				pipe.HSet(ctx, prefix+"clue:"+id, fields)
+				pipe.SAdd(ctx, prefix+"clues", id)
+				if c.Category != "" {
+					pipe.SAdd(ctx, prefix+"category:"+c.Category, id)
+					pipe.SAdd(ctx, prefix+"categories", c.Category)
+				}
+				if date, err := strconv.Atoi(strings.ReplaceAll(c.AirDate, "-", "")); err == nil {
+					pipe.ZAdd(ctx, prefix+"airdate", redis.Z{Score: float64(date), Member: id})
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("error loading clues into Redis: %v", err)
+		}
+	}
+	return nil
+}
+
+// returns each clue's id, its stable clue_id
+func redisClueIDs(clues []dataset.Clue) []string {
+	ids := make([]string, len(clues))
+	for i := range clues {
+		ids[i] = clues[i].ID()
+	}
+	return ids
+}
@@ -0,0 +1,32 @@
+package export
+
+import (
+	"slices"
+	"testing"
+)
+
+// checks that the golden clues' Redis ids are their clue_ids, unique, and
+// the same however the clues are ordered
+func TestRedisClueIDs(t *testing.T) {
+	clues := goldenClues(t)
+	ids := redisClueIDs(clues)
+	seen := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if id != clues[i].ID() {
+			t.Errorf("clue %d: id %q, want its clue_id %q", i, id, clues[i].ID())
+		}
+		if j, ok := seen[id]; ok {
+			t.Errorf("clues %d and %d share the id %q", j, i, id)
+		}
+		seen[id] = i
+	}
+
+	reversed := slices.Clone(clues)
+	slices.Reverse(reversed)
+	rids := redisClueIDs(reversed)
+	for i, id := range rids {
+		if want := ids[len(ids)-1-i]; id != want {
+			t.Errorf("reversed clue %d: id %q, want %q as in CSV order", i, id, want)
+		}
+	}
+}
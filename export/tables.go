@@ -0,0 +1,106 @@
+package export
+
+import (
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// Game is a row of the games table: one per season and show number, with
+// its ID the stable jarchive.StableGameID
+type Game struct {
+	ID string
+	// J! Archive's game_id, empty if it isn't known
+	JArchiveID      string
+	Season          string
+	EpisodeNumber   string
+	AirDate         string
+	Tournament      string
+	TournamentStage string
+	TournamentGame  int
+	Host            string
+	Format          string
+}
+
+// Round is a row of the rounds table: one per round a game's clues were
+// played in, numbered from 1 in the order they first appear, with the
+// stable jarchive.RoundID
+type Round struct {
+	ID     string
+	GameID string
+	Number int
+	Name   string
+}
+
+// Category is a row of the categories table: each distinct name once
+type Category struct {
+	ID   int
+	Name string
+}
+
+// Clue is a row of the clues table, pointing at its game, round and
+// category by ID; its own ID is the clue's stable dataset.Clue.ID
+type Clue struct {
+	ID         string
+	GameID     string
+	RoundID    string
+	CategoryID int
+	*dataset.Clue
+}
+
+// Tables is the clues split into the normalized tables parse -layout
+// normalized writes, apart from contestants, which the season CSVs don't
+// have
+type Tables struct {
+	Games      []Game
+	Rounds     []Round
+	Categories []Category
+	Clues      []Clue
+}
+
+// splits clues into games, rounds, categories and clues, the clues keeping
+// their order
+func Normalize(clues []dataset.Clue) *Tables {
+	type key struct{ season, epNum string }
+	t := &Tables{Clues: make([]Clue, 0, len(clues))}
+	gameIDs := make(map[key]string)
+	roundIDs := make(map[string]bool)
+	// the rounds of each game so far
+	gameRounds := make(map[string]int)
+	categoryIDs := make(map[string]int)
+	for i := range clues {
+		c := &clues[i]
+		gameID, ok := gameIDs[key{c.Season, c.EpisodeNumber}]
+		if !ok {
+			gameID = jarchive.StableGameID(c.GameID, c.Season, c.EpisodeNumber)
+			gameIDs[key{c.Season, c.EpisodeNumber}] = gameID
+			t.Games = append(t.Games, Game{
+				ID: gameID, JArchiveID: c.GameID, Season: c.Season, EpisodeNumber: c.EpisodeNumber, AirDate: c.AirDate,
+				Tournament: c.Tournament, TournamentStage: c.TournamentStage, TournamentGame: c.TournamentGame,
+				Host: c.Host, Format: c.Format,
+			})
+		}
+		roundID := jarchive.RoundID(c.GameID, c.Season, c.EpisodeNumber, c.Round)
+		if !roundIDs[roundID] {
+			roundIDs[roundID] = true
+			gameRounds[gameID]++
+			t.Rounds = append(t.Rounds, Round{ID: roundID, GameID: gameID, Number: gameRounds[gameID], Name: c.Round})
+		}
+		categoryID, ok := categoryIDs[c.Category]
+		if !ok {
+			categoryID = len(t.Categories) + 1
+			categoryIDs[c.Category] = categoryID
+			t.Categories = append(t.Categories, Category{ID: categoryID, Name: c.Category})
+		}
+		t.Clues = append(t.Clues, Clue{ID: c.ID(), GameID: gameID, RoundID: roundID, CategoryID: categoryID, Clue: c})
+	}
+	return t
+}
+
+// returns v, or nil (NULL) for the zero value the CSVs leave empty
+func orNull[T comparable](v T) any {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,534 @@
+CREATE TABLE IF NOT EXISTS games (
+	season VARCHAR(32) NOT NULL,
+	epNum VARCHAR(16) NOT NULL,
+	jarchive_game_id VARCHAR(16) NULL,
+	airDate DATE NULL,
+	tournament VARCHAR(255) NULL,
+	tournament_stage VARCHAR(32) NULL,
+	tournament_game SMALLINT NULL,
+	host VARCHAR(255) NULL,
+	game_format VARCHAR(16) NOT NULL,
+	PRIMARY KEY (season, epNum)
+) DEFAULT CHARSET = utf8mb4
+CREATE TABLE IF NOT EXISTS clues (
+	clue_id CHAR(16) NOT NULL,
+	season VARCHAR(32) NOT NULL,
+	epNum VARCHAR(16) NOT NULL,
+	round_name VARCHAR(32) NOT NULL,
+	board_column TINYINT NOT NULL,
+	board_row TINYINT NOT NULL,
+	category VARCHAR(255) NOT NULL,
+	value INT NULL,
+	value_raw VARCHAR(32) NOT NULL,
+	daily_double BOOLEAN NOT NULL,
+	question TEXT NOT NULL,
+	clue_notes TEXT NOT NULL,
+	answer TEXT NOT NULL,
+	triple_stumper BOOLEAN NOT NULL,
+	revealed BOOLEAN NOT NULL,
+	PRIMARY KEY (season, epNum, round_name, board_column, board_row),
+	UNIQUE KEY (clue_id),
+	FOREIGN KEY (season, epNum) REFERENCES games (season, epNum)
+) DEFAULT CHARSET = utf8mb4
+BEGIN
+INSERT INTO games (season, epNum, jarchive_game_id, airDate, tournament, tournament_stage, tournament_game, host, game_format) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE jarchive_game_id = VALUES(jarchive_game_id), airDate = VALUES(airDate), tournament = VALUES(tournament), tournament_stage = VALUES(tournament_stage), tournament_game = VALUES(tournament_game), host = VALUES(host), game_format = VALUES(game_format)
+	"celebrity", "9101", "7500", "2022-09-25", "Celebrity Jeopardy!", "quarterfinal", 1, "Ken Jennings", "celebrity"
+	"daily-doubles", "8123", "6500", "2019-10-01", NULL, NULL, NULL, "Alex Trebek", "regular"
+	"old-era", "2481", NULL, "1995-05-12", NULL, NULL, NULL, "Alex Trebek", "regular"
+	"regular", "9000", "7950", "2023-09-11", NULL, NULL, NULL, "Ken Jennings", "regular"
+	"super", "5001", "4001", "1990-06-16", "Super Jeopardy!", "quarterfinal", 1, "Alex Trebek", "regular"
+	"team", "8012", "6200", "2019-02-20", "All-Star Games", NULL, 1, "Alex Trebek", "team"
+	"tiebreaker", "6000", "3400", "2010-09-13", NULL, NULL, NULL, "Alex Trebek", "regular"
+	"tournament", "8965", "8480", "2023-11-07", "Tournament of Champions", "final", 1, "Ken Jennings", "regular"
+INSERT INTO clues (season, epNum, round_name, board_column, board_row, clue_id, category, value, value_raw, daily_double, question, clue_notes, answer, triple_stumper, revealed) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?), (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE clue_id = VALUES(clue_id), category = VALUES(category), value = VALUES(value), value_raw = VALUES(value_raw), daily_double = VALUES(daily_double), question = VALUES(question), clue_notes = VALUES(clue_notes), answer = VALUES(answer), triple_stumper = VALUES(triple_stumper), revealed = VALUES(revealed)
+	"celebrity", "9101", "Double Jeopardy", 1, 1, "8b93ced26db5c29e", "DJ A", 200, "$200", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 1, 2, "7a14de4c82ec216d", "DJ A", 400, "$400", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 1, 3, "a5efa32217c4a542", "DJ A", 600, "$600", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 1, 4, "a058b0b3ea467220", "DJ A", 800, "$800", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"celebrity", "9101", "Double Jeopardy", 1, 5, "082a0ed63bc18a1d", "DJ A", 1000, "$1,000", false, "DJ clue in column 1, row 5", "", "DJ response 1-5", false, true
+	"celebrity", "9101", "Double Jeopardy", 2, 1, "74b2e04d48c25da8", "DJ B", 200, "$200", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 2, 2, "aab294b6b289129e", "DJ B", 400, "$400", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 2, 4, "7e6c4215c46fa9e2", "DJ B", 800, "$800", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"celebrity", "9101", "Double Jeopardy", 2, 5, "6302cde2740047e5", "DJ B", 1000, "$1,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+	"celebrity", "9101", "Double Jeopardy", 2, 3, "e91cfdcea7a94fd2", "DJ B", 1200, "DD: $1,200", true, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 3, 1, "8203edc9ffed4652", "DJ C", 200, "$200", false, "DJ clue in column 3, row 1", "", "DJ response 3-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 3, 2, "397bfcd88a38f689", "DJ C", 400, "$400", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 3, 3, "897a2212830b84c6", "DJ C", 600, "$600", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 3, 4, "fb27500d0ffdfe61", "DJ C", 800, "$800", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"celebrity", "9101", "Double Jeopardy", 3, 5, "04bd19b420e9446b", "DJ C", 1000, "$1,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"celebrity", "9101", "Double Jeopardy", 4, 1, "0507b410effe8e39", "DJ D", 200, "$200", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 4, 2, "6371111849e32a76", "DJ D", 400, "$400", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 4, 3, "b0616ad599bf7b98", "DJ D", 600, "$600", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 4, 4, "66c48bd834db73aa", "DJ D", 800, "$800", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"celebrity", "9101", "Double Jeopardy", 4, 5, "f30f6b7fd403513e", "DJ D", 1000, "$1,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"celebrity", "9101", "Double Jeopardy", 5, 1, "01d0136dcde2f8ca", "DJ E", 200, "$200", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 5, 2, "12bc2c2357d05830", "DJ E", 400, "$400", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 5, 3, "1e3b0ad51da7391b", "DJ E", 600, "$600", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 5, 4, "32199b6ba5783987", "DJ E", 800, "$800", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"celebrity", "9101", "Double Jeopardy", 5, 5, "0129e4c89d9896f0", "DJ E", 2000, "DD: $2,000", true, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"celebrity", "9101", "Double Jeopardy", 6, 1, "35b737780c8cd3ca", "DJ F", 200, "$200", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"celebrity", "9101", "Double Jeopardy", 6, 2, "5a916651dc5ea152", "DJ F", 400, "$400", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"celebrity", "9101", "Double Jeopardy", 6, 3, "2c4bd08814380ba5", "DJ F", 600, "$600", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"celebrity", "9101", "Double Jeopardy", 6, 4, "60c2ec46ffcd330c", "DJ F", 800, "$800", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"celebrity", "9101", "Jeopardy", 1, 1, "a7deb417b4f01ca1", "J A", 100, "$100", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"celebrity", "9101", "Jeopardy", 1, 2, "f7439b55a1e2f81a", "J A", 200, "$200", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"celebrity", "9101", "Jeopardy", 1, 3, "77307d28138987dc", "J A", 300, "$300", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"celebrity", "9101", "Jeopardy", 1, 4, "aa6e140a07e7d438", "J A", 400, "$400", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"celebrity", "9101", "Jeopardy", 1, 5, "f3b1e821caf77f74", "J A", 500, "$500", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"celebrity", "9101", "Jeopardy", 2, 1, "740cb6f79c19120f", "J B", 100, "$100", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"celebrity", "9101", "Jeopardy", 2, 2, "1c937c58c388f3c9", "J B", 200, "$200", false, "J clue in column 2, row 2", "", "J response 2-2", false, true
+	"celebrity", "9101", "Jeopardy", 2, 3, "87b657dde1be1e47", "J B", 300, "$300", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"celebrity", "9101", "Jeopardy", 2, 4, "05c4d7c4a67a6ffe", "J B", 400, "$400", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"celebrity", "9101", "Jeopardy", 2, 5, "f34fe4fdf04a8684", "J B", 500, "$500", false, "J clue in column 2, row 5", "", "J response 2-5", false, true
+	"celebrity", "9101", "Jeopardy", 3, 1, "1c1330f3d75e3c46", "J C", 100, "$100", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"celebrity", "9101", "Jeopardy", 3, 2, "540609d5c744aaa4", "J C", 200, "$200", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"celebrity", "9101", "Jeopardy", 3, 3, "a0b10ec48c2fcaf0", "J C", 300, "$300", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"celebrity", "9101", "Jeopardy", 3, 5, "be733df26400f499", "J C", 500, "$500", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"celebrity", "9101", "Jeopardy", 3, 4, "60005b0e94f6141a", "J C", 800, "DD: $800", true, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"celebrity", "9101", "Jeopardy", 4, 1, "c2b1bc4a828185f1", "J D", 100, "$100", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"celebrity", "9101", "Jeopardy", 4, 2, "bbeb271a1f8768f1", "J D", 200, "$200", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"celebrity", "9101", "Jeopardy", 4, 3, "d17f8621c0d938da", "J D", 300, "$300", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"celebrity", "9101", "Jeopardy", 4, 4, "17dda19ed3843e9f", "J D", 400, "$400", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"celebrity", "9101", "Jeopardy", 4, 5, "dbace7f56c0d059d", "J D", 500, "$500", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"celebrity", "9101", "Jeopardy", 5, 1, "80d34d2dfa7f46ae", "J E", 100, "$100", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"celebrity", "9101", "Jeopardy", 5, 2, "61febdf549e83825", "J E", 200, "$200", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"celebrity", "9101", "Jeopardy", 5, 3, "72c11ae719fbfdf1", "J E", 300, "$300", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"celebrity", "9101", "Jeopardy", 5, 4, "5e85299105e093f6", "J E", 400, "$400", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"celebrity", "9101", "Jeopardy", 5, 5, "4c6218dde0ecc867", "J E", 500, "$500", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"celebrity", "9101", "Jeopardy", 6, 1, "f2f1051897714f5d", "J F", 100, "$100", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"celebrity", "9101", "Jeopardy", 6, 2, "4ecf8654c5c88fc2", "J F", 200, "$200", false, "J clue in column 6, row 2", "", "J response 6-2", false, true
+	"celebrity", "9101", "Jeopardy", 6, 3, "ded7cebc4ae5f33f", "J F", 300, "$300", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"celebrity", "9101", "Jeopardy", 6, 4, "7c998c202d1eaade", "J F", 400, "$400", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"celebrity", "9101", "Jeopardy", 6, 5, "69ad96e5ff53ebd7", "J F", 500, "$500", false, "J clue in column 6, row 5", "", "J response 6-5", false, true
+	"celebrity", "9101", "Final Jeopardy", 0, 0, "e374bf08c6f8f13e", "MOVIE QUOTES", NULL, "", false, "This 1942 film gave us \"Here's looking at you, kid\"", "", "Casablanca", false, true
+	"celebrity", "9101", "Triple Jeopardy", 1, 1, "853e0bde95638c9a", "TJ A", 300, "$300", false, "TJ clue in column 1, row 1", "", "TJ response 1-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 1, 2, "207b3f4838a56e09", "TJ A", 600, "$600", false, "TJ clue in column 1, row 2", "", "TJ response 1-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 1, 3, "6c5bb4fadaa4cb1d", "TJ A", 900, "$900", false, "TJ clue in column 1, row 3", "", "TJ response 1-3", false, true
+	"celebrity", "9101", "Triple Jeopardy", 1, 4, "a5c6f0ef510f64c6", "TJ A", 1200, "$1,200", false, "TJ clue in column 1, row 4", "", "TJ response 1-4", false, true
+	"celebrity", "9101", "Triple Jeopardy", 1, 5, "8bbc550e6ec10b21", "TJ A", 3000, "DD: $3,000", true, "TJ clue in column 1, row 5", "", "TJ response 1-5", false, true
+	"celebrity", "9101", "Triple Jeopardy", 2, 1, "b0234d993bb80a64", "TJ B", 300, "$300", false, "TJ clue in column 2, row 1", "", "TJ response 2-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 2, 2, "a13a17744dc9bb47", "TJ B", 600, "$600", false, "TJ clue in column 2, row 2", "", "TJ response 2-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 2, 3, "81b4ed7c896791f4", "TJ B", 900, "$900", false, "TJ clue in column 2, row 3", "", "TJ response 2-3", false, true
+	"celebrity", "9101", "Triple Jeopardy", 2, 4, "8afdbd2433f6866a", "TJ B", 1200, "$1,200", false, "TJ clue in column 2, row 4", "", "TJ response 2-4", false, true
+	"celebrity", "9101", "Triple Jeopardy", 3, 1, "91b49738c4bc50aa", "TJ C", 300, "$300", false, "TJ clue in column 3, row 1", "", "TJ response 3-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 3, 2, "60d23333b9f0ff73", "TJ C", 600, "$600", false, "TJ clue in column 3, row 2", "", "TJ response 3-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 3, 3, "78e1d1992992aafe", "TJ C", 900, "$900", false, "TJ clue in column 3, row 3", "", "TJ response 3-3", false, true
+	"celebrity", "9101", "Triple Jeopardy", 3, 4, "6127e9377bf47658", "TJ C", 1200, "$1,200", false, "TJ clue in column 3, row 4", "", "TJ response 3-4", false, true
+	"celebrity", "9101", "Triple Jeopardy", 4, 1, "937ca21399d84332", "TJ D", 300, "$300", false, "TJ clue in column 4, row 1", "", "TJ response 4-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 4, 2, "731767bb4361bb64", "TJ D", 600, "$600", false, "TJ clue in column 4, row 2", "", "TJ response 4-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 4, 3, "becd1e51c59a0d10", "TJ D", 900, "$900", false, "TJ clue in column 4, row 3", "", "TJ response 4-3", false, true
+	"celebrity", "9101", "Triple Jeopardy", 4, 5, "2c45e7a51bc2df7b", "TJ D", 1500, "$1,500", false, "TJ clue in column 4, row 5", "", "TJ response 4-5", false, true
+	"celebrity", "9101", "Triple Jeopardy", 4, 4, "f6e3db5904c52979", "TJ D", 2400, "DD: $2,400", true, "TJ clue in column 4, row 4", "", "TJ response 4-4", false, true
+	"celebrity", "9101", "Triple Jeopardy", 5, 1, "2cde4c59d805a439", "TJ E", 300, "$300", false, "TJ clue in column 5, row 1", "", "TJ response 5-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 5, 2, "f0d5f3a5af9db285", "TJ E", 600, "$600", false, "TJ clue in column 5, row 2", "", "TJ response 5-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 5, 3, "b3bf84a4590eddca", "TJ E", 900, "$900", false, "TJ clue in column 5, row 3", "", "TJ response 5-3", false, true
+	"celebrity", "9101", "Triple Jeopardy", 5, 4, "9d64964330031b31", "TJ E", 1200, "$1,200", false, "TJ clue in column 5, row 4", "", "TJ response 5-4", true, true
+	"celebrity", "9101", "Triple Jeopardy", 5, 5, "392c45848543db39", "TJ E", 1500, "$1,500", false, "TJ clue in column 5, row 5", "", "TJ response 5-5", false, true
+	"celebrity", "9101", "Triple Jeopardy", 6, 1, "3d01293456a92a9f", "TJ F", 300, "$300", false, "TJ clue in column 6, row 1", "", "TJ response 6-1", false, true
+	"celebrity", "9101", "Triple Jeopardy", 6, 2, "6e9be3e7e5b0ff2e", "TJ F", 600, "$600", false, "TJ clue in column 6, row 2", "", "TJ response 6-2", false, true
+	"celebrity", "9101", "Triple Jeopardy", 6, 4, "22545a54b99d70e7", "TJ F", 1200, "$1,200", false, "TJ clue in column 6, row 4", "", "TJ response 6-4", false, true
+	"celebrity", "9101", "Triple Jeopardy", 6, 5, "2f0283c8530333ea", "TJ F", 1500, "$1,500", false, "TJ clue in column 6, row 5", "", "TJ response 6-5", false, true
+	"celebrity", "9101", "Triple Jeopardy", 6, 3, "828442f4f6462b8e", "TJ F", 1800, "DD: $1,800", true, "TJ clue in column 6, row 3", "", "TJ response 6-3", false, true
+	"daily-doubles", "8123", "Final Jeopardy", 0, 0, "de33d70d02cb44d9", "AMERICAN AUTHORS", NULL, "", false, "His 1851 novel was dedicated to Nathaniel Hawthorne", "", "Herman Melville", false, true
+	"daily-doubles", "8123", "Jeopardy", 1, 1, "0bd50416f19b167b", "ANIMALS", 200, "$200", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 1, 2, "26333db3d9fd4e68", "ANIMALS", 400, "$400", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"daily-doubles", "8123", "Jeopardy", 1, 3, "53decbe05bfa3b66", "ANIMALS", 600, "$600", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 1, 5, "ed5e2458126e2654", "ANIMALS", 1000, "$1,000", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"daily-doubles", "8123", "Jeopardy", 1, 4, "36da33318b06a5a8", "ANIMALS", 5000, "DD: $5,000", true, "Clue under the first Daily Double", "", "first", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 6, 1, "ab4769c00f02ed6e", "CHEESE", 400, "$400", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 6, 2, "2730d4e69a7eb498", "CHEESE", 800, "$800", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 6, 3, "bec932c175a293eb", "CHEESE", 1200, "$1,200", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 6, 4, "5a4e8effaf02a170", "CHEESE", 1600, "$1,600", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 6, 5, "e03b6caf8c4037be", "CHEESE", 2000, "$2,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 3, 1, "60bb15277b46a6b2", "ISLANDS", 400, "$400", false, "The $400 clue, picked last", "", "bottom feeder", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 3, 2, "869c323326bf2ce9", "ISLANDS", 800, "$800", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 3, 3, "a0dc2f7e3ab873e8", "ISLANDS", 1200, "$1,200", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 3, 4, "d7139dbf10075342", "ISLANDS", 1600, "$1,600", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 3, 5, "948a932dd0cc8992", "ISLANDS", 2000, "$2,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 4, 1, "a6500ffc66b13763", "KINGS", 400, "$400", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 4, 2, "00462db45a51ba1c", "KINGS", 800, "$800", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 4, 3, "1a02a25fb2003538", "KINGS", 1200, "$1,200", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 4, 4, "7dec927100a03af0", "KINGS", 1600, "$1,600", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 4, 5, "e09149e6e7a96d2a", "KINGS", 2000, "$2,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"daily-doubles", "8123", "Jeopardy", 5, 1, "d6ce7015455f516c", "LAKES", 200, "$200", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 5, 2, "37f6e8b396aed103", "LAKES", 400, "$400", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"daily-doubles", "8123", "Jeopardy", 5, 3, "467ccde61dde16ca", "LAKES", 600, "$600", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 5, 4, "2a385d5c8d794e1b", "LAKES", 800, "$800", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"daily-doubles", "8123", "Jeopardy", 5, 5, "861d1cc814e83965", "LAKES", 1000, "$1,000", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 2, 1, "69a8338bc3826e0f", "NOVELS", 400, "$400", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 2, 2, "0653d1dc41d78608", "NOVELS", 800, "$800", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 2, 4, "63f4ee0d225da8ad", "NOVELS", 1600, "$1,600", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 2, 5, "f6e46f05c197accb", "NOVELS", 2000, "$2,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 2, 3, "e2c364a83710a06f", "NOVELS", 12000, "DD: $12,000", true, "Bet it all here", "", "all in", false, true
+	"daily-doubles", "8123", "Jeopardy", 3, 1, "a8512a79a4330170", "OPERA", 200, "$200", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 3, 2, "629f35dfd9bb5108", "OPERA", 400, "$400", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"daily-doubles", "8123", "Jeopardy", 3, 3, "e313f920c56660c8", "OPERA", 600, "$600", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 3, 4, "57d6047fb374917d", "OPERA", 800, "$800", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 1, 1, "8f1a78fb37d19bac", "PHYSICS", 400, "$400", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 1, 2, "4278042a8b1e6149", "PHYSICS", 800, "$800", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 1, 3, "9545bb25d5057272", "PHYSICS", 1200, "$1,200", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 1, 4, "f6ba23090ffa8fe6", "PHYSICS", 1600, "$1,600", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 1, 5, "bd6d8ba2d496b304", "PHYSICS", 2000, "$2,000", false, "DJ clue in column 1, row 5", "", "DJ response 1-5", false, true
+	"daily-doubles", "8123", "Jeopardy", 2, 1, "f5783f6556c45c47", "POETS", 200, "$200", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 2, 2, "41665fbdd8efb3f0", "POETS", 400, "$400", false, "J clue in column 2, row 2", "", "J response 2-2", false, true
+	"daily-doubles", "8123", "Jeopardy", 2, 3, "e598697ed0c899b0", "POETS", 600, "$600", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 2, 4, "99a544ac6af65024", "POETS", 800, "$800", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"daily-doubles", "8123", "Jeopardy", 6, 1, "705051c0f15463fc", "SNACKS", 200, "$200", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 6, 3, "8948a8009c97cafc", "SNACKS", 600, "$600", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 6, 4, "f9db0df5a6a3b7aa", "SNACKS", 800, "$800", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"daily-doubles", "8123", "Jeopardy", 6, 5, "b175e4b8dd9ecfa5", "SNACKS", 1000, "$1,000", false, "J clue in column 6, row 5", "", "J response 6-5", false, true
+	"daily-doubles", "8123", "Jeopardy", 6, 2, "7fd860758e44af00", "SNACKS", 400, "DD: $400", true, "A true Daily Double early in the game", "", "true daily double", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 5, 1, "c7fbe26ade605143", "SONGS", 400, "$400", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 5, 2, "cc0c89acb617223e", "SONGS", 800, "$800", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 5, 3, "d1bc879184c95752", "SONGS", 1200, "$1,200", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 5, 4, "1164f30d22980386", "SONGS", 1600, "$1,600", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"daily-doubles", "8123", "Double Jeopardy", 5, 5, "cacd7cd6339ff159", "SONGS", 1, "DD: $1", true, "Last Daily Double of the night", "", "last one", false, true
+	"daily-doubles", "8123", "Jeopardy", 4, 1, "1812515f33c34f56", "TV", 200, "$200", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"daily-doubles", "8123", "Jeopardy", 4, 2, "a0d3b40864c2c1ba", "TV", 400, "$400", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"daily-doubles", "8123", "Jeopardy", 4, 3, "8c96d6c363a7f414", "TV", 600, "$600", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"daily-doubles", "8123", "Jeopardy", 4, 4, "4402f5d5bc8e89ed", "TV", 800, "$800", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"old-era", "2481", "Double Jeopardy", 2, 1, "3f2ccd863fe3f00e", "ART", 200, "$200", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"old-era", "2481", "Double Jeopardy", 2, 2, "51d5343b7cadacd7", "ART", 400, "$400", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"old-era", "2481", "Double Jeopardy", 2, 3, "7eecd45d367eb503", "ART", 600, "$600", false, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"old-era", "2481", "Double Jeopardy", 2, 4, "71063858202eda6f", "ART", 800, "$800", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"old-era", "2481", "Jeopardy", 3, 1, "d379e513caeead0d", "AUTHORS", 100, "$100", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"old-era", "2481", "Jeopardy", 3, 2, "e781a6ac57695422", "AUTHORS", 200, "$200", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"old-era", "2481", "Jeopardy", 3, 3, "bd9ff4babc75fc7d", "AUTHORS", 300, "$300", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"old-era", "2481", "Jeopardy", 3, 4, "2a4394786f61ffe9", "AUTHORS", 400, "$400", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"old-era", "2481", "Jeopardy", 3, 5, "d6c345d2d15bba12", "AUTHORS", 500, "$500", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"old-era", "2481", "Double Jeopardy", 4, 1, "7df3fe1711c11b7e", "FOOD", 200, "$200", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"old-era", "2481", "Double Jeopardy", 4, 2, "de9f4fbf46c82399", "FOOD", 400, "$400", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"old-era", "2481", "Double Jeopardy", 4, 3, "b1987e47e0ede255", "FOOD", 600, "$600", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"old-era", "2481", "Double Jeopardy", 4, 4, "5aa44b8ccabd67f9", "FOOD", 800, "$800", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"old-era", "2481", "Double Jeopardy", 4, 5, "5dce7ad59cc4df32", "FOOD", 1000, "$1,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"old-era", "2481", "Jeopardy", 2, 1, "883f89faedb65c75", "GEOGRAPHY", 100, "$100", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"old-era", "2481", "Jeopardy", 2, 2, "a2b4a90df37c2f52", "GEOGRAPHY", 200, "$200", false, "This president appears on the $5 bill", "Alex: Here we go.", "Abraham Lincoln", false, true
+	"old-era", "2481", "Jeopardy", 2, 3, "3471e348619b6e9c", "GEOGRAPHY", 300, "$300", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"old-era", "2481", "Jeopardy", 2, 4, "736de3cea7f0f951", "GEOGRAPHY", 400, "$400", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"old-era", "2481", "Jeopardy", 2, 5, "4601213654c0576e", "GEOGRAPHY", 500, "$500", false, "J clue in column 2, row 5", "", "J response 2-5", false, true
+	"old-era", "2481", "Double Jeopardy", 3, 1, "b20c85ad54bc6e80", "HISTORY", 200, "$200", false, "DJ clue in column 3, row 1", "", "DJ response 3-1", false, true
+	"old-era", "2481", "Double Jeopardy", 3, 2, "fe2194dcbc003a6d", "HISTORY", 400, "$400", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"old-era", "2481", "Double Jeopardy", 3, 3, "ec86148063c9d63c", "HISTORY", 600, "$600", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"old-era", "2481", "Double Jeopardy", 3, 4, "8d61c00e81ac909e", "HISTORY", 800, "$800", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"old-era", "2481", "Double Jeopardy", 3, 5, "9aebf7e110a90635", "HISTORY", 1000, "$1,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"old-era", "2481", "Double Jeopardy", 1, 1, "9df2f4f175ddcea4", "MUSIC", 200, "$200", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"old-era", "2481", "Double Jeopardy", 1, 2, "4238a1cc5f13c2c5", "MUSIC", 400, "$400", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"old-era", "2481", "Double Jeopardy", 1, 3, "a5b43e47d49d4866", "MUSIC", 600, "$600", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"old-era", "2481", "Double Jeopardy", 1, 4, "de99477fc66fc408", "MUSIC", 800, "$800", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"old-era", "2481", "Jeopardy", 6, 1, "bb5a1fd1a52c4a32", "POTPOURRI", 100, "$100", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"old-era", "2481", "Jeopardy", 6, 2, "f181ae4bc4ef5d29", "POTPOURRI", 200, "$200", false, "J clue in column 6, row 2", "", "J response 6-2", false, true
+	"old-era", "2481", "Jeopardy", 6, 3, "2ebdb06fd3da9807", "POTPOURRI", 300, "$300", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"old-era", "2481", "Jeopardy", 6, 4, "e819ca8edaf421a4", "POTPOURRI", 400, "$400", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"old-era", "2481", "Jeopardy", 1, 1, "034d2543d7468133", "PRESIDENTS", 100, "$100", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"old-era", "2481", "Jeopardy", 1, 2, "046705fb4ded0990", "PRESIDENTS", 200, "$200", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"old-era", "2481", "Jeopardy", 1, 3, "2822b9be2868c374", "PRESIDENTS", 300, "$300", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"old-era", "2481", "Jeopardy", 1, 4, "d0144c54f1845a9d", "PRESIDENTS", 400, "$400", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"old-era", "2481", "Jeopardy", 1, 5, "a91b00e03d5653a2", "PRESIDENTS", 500, "$500", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"old-era", "2481", "Jeopardy", 5, 1, "86d9e92d15f99bd1", "RIVERS", 100, "$100", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"old-era", "2481", "Jeopardy", 5, 2, "6211c4ecc54513d9", "RIVERS", 200, "$200", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"old-era", "2481", "Jeopardy", 5, 4, "c8fc0634ed165d44", "RIVERS", 400, "$400", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"old-era", "2481", "Jeopardy", 5, 5, "ac0c6d2d28a12489", "RIVERS", 500, "$500", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"old-era", "2481", "Jeopardy", 5, 3, "235a2865b3a62a1a", "RIVERS", 500, "DD: $500", true, "This river flows through Cairo and Khartoum", "", "the Nile", false, true
+	"old-era", "2481", "Jeopardy", 4, 1, "bf9c6f81836132ff", "SCIENCE", 100, "$100", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"old-era", "2481", "Jeopardy", 4, 2, "5a2219217497bbb0", "SCIENCE", 200, "$200", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"old-era", "2481", "Jeopardy", 4, 3, "8fd9813730017830", "SCIENCE", 300, "$300", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"old-era", "2481", "Jeopardy", 4, 4, "d374c317c18d52c7", "SCIENCE", 400, "$400", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"old-era", "2481", "Jeopardy", 4, 5, "d7c0cbdf526441d8", "SCIENCE", 500, "$500", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"old-era", "2481", "Double Jeopardy", 5, 1, "d9ea7058b7cc7b21", "SPORTS", 200, "$200", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"old-era", "2481", "Double Jeopardy", 5, 2, "160299351f9a3d5e", "SPORTS", 400, "$400", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"old-era", "2481", "Double Jeopardy", 5, 3, "3b6b93ae3893dd4a", "SPORTS", 600, "$600", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"old-era", "2481", "Double Jeopardy", 5, 4, "e7cc17549b09303a", "SPORTS", 800, "$800", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"old-era", "2481", "Double Jeopardy", 5, 5, "4327320b8b934a43", "SPORTS", 1000, "$1,000", false, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"old-era", "2481", "Final Jeopardy", 0, 0, "da45adaf76c3d92f", "U.S. STATES", NULL, "", false, "It was the last of the original 13 colonies to ratify the Constitution", "", "Rhode Island", false, true
+	"old-era", "2481", "Double Jeopardy", 6, 1, "32c1eb912a7e229d", "WORDS", 200, "$200", false, "A line break inside the clue text", "", "line break", false, true
+	"old-era", "2481", "Double Jeopardy", 6, 2, "1a6930cf08eac2db", "WORDS", 400, "$400", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"old-era", "2481", "Double Jeopardy", 6, 3, "331b59386423f657", "WORDS", 600, "$600", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"old-era", "2481", "Double Jeopardy", 6, 4, "15a770f41401fcd6", "WORDS", 800, "$800", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"old-era", "2481", "Double Jeopardy", 6, 5, "d0032be0b13b2be9", "WORDS", 1000, "$1,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"regular", "9000", "Jeopardy", 6, 1, "68018ca97236de22", "\"B\" MOVIES", 200, "$200", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"regular", "9000", "Jeopardy", 6, 2, "1579eb026c1c45d6", "\"B\" MOVIES", 400, "$400", false, "J clue in column 6, row 2", "", "J response 6-2", false, true
+	"regular", "9000", "Jeopardy", 6, 3, "16595e29d166ded5", "\"B\" MOVIES", 600, "$600", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"regular", "9000", "Jeopardy", 6, 4, "a04508282192bc8c", "\"B\" MOVIES", 800, "$800", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"regular", "9000", "Double Jeopardy", 1, 1, "aa072f373c55a1dd", "ART", 400, "$400", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"regular", "9000", "Double Jeopardy", 1, 2, "8fdc65b5c5d025ff", "ART", 800, "$800", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"regular", "9000", "Double Jeopardy", 1, 3, "3c40a0ea4988ac00", "ART", 1200, "$1,200", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"regular", "9000", "Double Jeopardy", 1, 4, "feb891cc5b4005b7", "ART", 1600, "$1,600", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"regular", "9000", "Double Jeopardy", 1, 5, "ae05e23f142e373d", "ART", 3000, "DD: $3,000", true, "This Dutch painter cut off part of his ear in 1888", "", "Vincent van Gogh", false, true
+	"regular", "9000", "Double Jeopardy", 3, 1, "0cbf43e92ba92c22", "BEFORE & AFTER", 400, "$400", false, "Lord of the Rings author who's also a 1960s British rock band with \"Tommy\"", "", "J.R.R. Tolkien the Who", false, true
+	"regular", "9000", "Double Jeopardy", 3, 2, "8f1dc104959944c5", "BEFORE & AFTER", 800, "$800", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"regular", "9000", "Double Jeopardy", 3, 3, "08ede8e0a2aca452", "BEFORE & AFTER", 1200, "$1,200", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"regular", "9000", "Double Jeopardy", 3, 4, "a8815fcce0f58538", "BEFORE & AFTER", 1600, "$1,600", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"regular", "9000", "Double Jeopardy", 3, 5, "129493971d5b3d8f", "BEFORE & AFTER", 2000, "$2,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"regular", "9000", "Double Jeopardy", 5, 1, "d7667dd059b11dd8", "FILM", 400, "$400", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"regular", "9000", "Double Jeopardy", 5, 2, "f5b7d4175a4969e7", "FILM", 800, "$800", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"regular", "9000", "Double Jeopardy", 5, 3, "2a944308d3d65533", "FILM", 1200, "$1,200", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"regular", "9000", "Double Jeopardy", 5, 4, "37a6a8fc11883bac", "FILM", 1600, "$1,600", false, "This 1942 film features the line \"Here's looking at you, kid\"", "", "Casablanca", false, true
+	"regular", "9000", "Double Jeopardy", 5, 5, "c4122ad7ffd1350a", "FILM", 2000, "$2,000", false, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"regular", "9000", "Double Jeopardy", 4, 1, "bc5c70523cddc17e", "FOOD", 400, "$400", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"regular", "9000", "Double Jeopardy", 4, 3, "06897266e77db0a8", "FOOD", 1200, "$1,200", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"regular", "9000", "Double Jeopardy", 4, 4, "f6b13d60b2505bb0", "FOOD", 1600, "$1,600", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"regular", "9000", "Double Jeopardy", 4, 5, "f5ecfc666a220764", "FOOD", 2000, "$2,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"regular", "9000", "Double Jeopardy", 4, 2, "278143cffa793ed5", "FOOD", 2000, "DD: $2,000", true, "It's the main ingredient in guacamole", "Ken: Let's have some fun.", "avocado", false, true
+	"regular", "9000", "Jeopardy", 3, 1, "e4a14040e58da5d1", "POTENT POTABLES", 200, "$200", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"regular", "9000", "Jeopardy", 3, 2, "4e621596af802ee3", "POTENT POTABLES", 400, "$400", false, "A martini is traditionally garnished with an olive or this citrus peel", "", "a lemon twist", false, true
+	"regular", "9000", "Jeopardy", 3, 3, "3804427e455b7289", "POTENT POTABLES", 600, "$600", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"regular", "9000", "Jeopardy", 3, 4, "dc253f18cdd51f82", "POTENT POTABLES", 800, "$800", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"regular", "9000", "Jeopardy", 3, 5, "176f6d6016ea6e01", "POTENT POTABLES", 1000, "$1,000", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"regular", "9000", "Double Jeopardy", 6, 1, "7cb3a6d8ecabd334", "RHYME TIME", 400, "$400", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"regular", "9000", "Double Jeopardy", 6, 2, "048370477130d78e", "RHYME TIME", 800, "$800", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"regular", "9000", "Double Jeopardy", 6, 3, "8086b7b590c40382", "RHYME TIME", 1200, "$1,200", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"regular", "9000", "Double Jeopardy", 6, 4, "694281faae40f27c", "RHYME TIME", 1600, "$1,600", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"regular", "9000", "Double Jeopardy", 6, 5, "c5eb0dc220840655", "RHYME TIME", 2000, "$2,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"regular", "9000", "Jeopardy", 1, 1, "96f6cda098a6863b", "SCIENCE", 200, "$200", false, "This gas makes up about 78% of Earth's atmosphere", "", "nitrogen", false, true
+	"regular", "9000", "Jeopardy", 1, 2, "63444a585486143f", "SCIENCE", 400, "$400", false, "Marie Curie's \"radioactivity\" research won this prize in 1903 & 1911", "", "the Nobel Prize", false, true
+	"regular", "9000", "Jeopardy", 1, 3, "8a2e71e8241f2b3d", "SCIENCE", 600, "$600", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"regular", "9000", "Jeopardy", 1, 4, "ba88a45d5c55d4d1", "SCIENCE", 800, "$800", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"regular", "9000", "Jeopardy", 1, 5, "89a38f668b5ec4b8", "SCIENCE", 1000, "$1,000", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"regular", "9000", "Jeopardy", 5, 1, "7ddd5050ef9e0225", "SPORTS", 200, "$200", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"regular", "9000", "Jeopardy", 5, 2, "0e7b648a9fdaa82d", "SPORTS", 400, "$400", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"regular", "9000", "Jeopardy", 5, 3, "ccc8e2bcb7d9ecd5", "SPORTS", 600, "$600", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"regular", "9000", "Jeopardy", 5, 4, "06f429678fda433b", "SPORTS", 800, "$800", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"regular", "9000", "Jeopardy", 2, 1, "6f09e71215501c00", "U.S. HISTORY", 200, "$200", false, "J clue in column 2, row 1", "Ken: Last name only.", "J response 2-1", false, true
+	"regular", "9000", "Jeopardy", 2, 2, "75b251df826cc150", "U.S. HISTORY", 400, "$400", false, "J clue in column 2, row 2", "Sarah of the Clue Crew reports from the Louvre in Paris. Ken: Be specific.", "J response 2-2", false, true
+	"regular", "9000", "Jeopardy", 2, 3, "b26896b891f8ec61", "U.S. HISTORY", 600, "$600", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"regular", "9000", "Jeopardy", 2, 4, "bf3ad09ee75babf4", "U.S. HISTORY", 800, "$800", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"regular", "9000", "Jeopardy", 2, 5, "0959a960ce398a0b", "U.S. HISTORY", 1000, "$1,000", false, "In 1803 the U.S. doubled in size thanks to this deal with France", "", "the Louisiana Purchase", true, true
+	"regular", "9000", "Jeopardy", 4, 1, "7f47983de3dac41e", "WORD ORIGINS", 200, "$200", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"regular", "9000", "Jeopardy", 4, 2, "dbeb5759d293851e", "WORD ORIGINS", 400, "$400", false, "J clue in column 4, row 2 (the kind of aside that stays)", "", "J response 4-2", false, true
+	"regular", "9000", "Jeopardy", 4, 4, "77396794d94e16ab", "WORD ORIGINS", 800, "$800", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"regular", "9000", "Jeopardy", 4, 5, "86f5d4549e0e0b5b", "WORD ORIGINS", 1000, "$1,000", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"regular", "9000", "Jeopardy", 4, 3, "357fa693845e53d0", "WORD ORIGINS", 1000, "DD: $1,000", true, "From the Latin for \"to breathe\", it's a living being's essence", "", "spirit", false, true
+	"regular", "9000", "Final Jeopardy", 0, 0, "bf845ab34707f68b", "WORLD CAPITALS", NULL, "", false, "Founded in 1826 as Bytown, it was chosen as a capital by Queen Victoria", "", "Ottawa", false, true
+	"regular", "9000", "Double Jeopardy", 2, 1, "51e18056c5078b3a", "WORLD GEOGRAPHY", 400, "$400", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"regular", "9000", "Double Jeopardy", 2, 2, "ac10423acfe91e86", "WORLD GEOGRAPHY", 800, "$800", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"regular", "9000", "Double Jeopardy", 2, 3, "8e4376e6bdf9b8aa", "WORLD GEOGRAPHY", 1200, "$1,200", false, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"regular", "9000", "Double Jeopardy", 2, 4, "15969ad20bfb7c46", "WORLD GEOGRAPHY", 1600, "$1,600", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"regular", "9000", "Double Jeopardy", 2, 5, "4f824e515ca0b1d2", "WORLD GEOGRAPHY", 2000, "$2,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+	"super", "5001", "Double Jeopardy", 3, 1, "3efe78374bff2e10", "ANATOMY", 500, "500", false, "Anatomy clue for 500 points in column 3, row 1", "", "response 3-1", false, true
+	"super", "5001", "Double Jeopardy", 3, 2, "9f3fd39e3e3a7ac8", "ANATOMY", 1000, "1000", false, "Anatomy clue for 1000 points in column 3, row 2", "", "response 3-2", false, true
+	"super", "5001", "Double Jeopardy", 3, 3, "68baf7bafa4dbe33", "ANATOMY", 1500, "1500", false, "Anatomy clue for 1500 points in column 3, row 3", "", "response 3-3", false, true
+	"super", "5001", "Double Jeopardy", 3, 4, "2f6f8fab9c717f88", "ANATOMY", 2000, "2000", false, "Anatomy clue for 2000 points in column 3, row 4", "", "response 3-4", true, true
+	"super", "5001", "Jeopardy", 1, 1, "cee7b4c1bf7ade0e", "ASTRONOMY", 200, "200", false, "Astronomy clue for 200 points in column 1, row 1", "", "response 1-1", false, true
+	"super", "5001", "Jeopardy", 1, 2, "4f16ae6ec6247d93", "ASTRONOMY", 400, "400", false, "Astronomy clue for 400 points in column 1, row 2", "", "response 1-2", false, true
+	"super", "5001", "Jeopardy", 1, 3, "19b28adbb16d53ee", "ASTRONOMY", 600, "600", false, "Astronomy clue for 600 points in column 1, row 3", "", "response 1-3", false, true
+	"super", "5001", "Jeopardy", 1, 5, "bbb793f7a5212ae3", "ASTRONOMY", 1000, "1000", false, "Astronomy clue for 1000 points in column 1, row 5", "", "response 1-5", false, true
+	"super", "5001", "Jeopardy", 6, 1, "1d93139769f65c39", "BIRDS", 200, "200", false, "Birds clue for 200 points in column 6, row 1", "", "response 6-1", false, true
+	"super", "5001", "Jeopardy", 6, 2, "9c47b4047d10c60a", "BIRDS", 400, "400", false, "Birds clue for 400 points in column 6, row 2", "", "response 6-2", false, true
+	"super", "5001", "Jeopardy", 6, 4, "d08685a048072685", "BIRDS", 800, "800", false, "Birds clue for 800 points in column 6, row 4", "", "response 6-4", false, true
+	"super", "5001", "Jeopardy", 6, 5, "26d3a0683c66b17f", "BIRDS", 1000, "1000", false, "Birds clue for 1000 points in column 6, row 5", "", "response 6-5", false, true
+	"super", "5001", "Double Jeopardy", 2, 1, "771ca483f199ea69", "COMPOSERS", 500, "500", false, "Composers clue for 500 points in column 2, row 1", "", "response 2-1", false, true
+	"super", "5001", "Double Jeopardy", 2, 2, "fcb0f9d41a30ede7", "COMPOSERS", 1000, "1000", false, "Composers clue for 1000 points in column 2, row 2", "", "response 2-2", false, true
+	"super", "5001", "Double Jeopardy", 2, 3, "9734fc3a515eacd5", "COMPOSERS", 1500, "1500", false, "Composers clue for 1500 points in column 2, row 3", "", "response 2-3", false, true
+	"super", "5001", "Double Jeopardy", 2, 4, "fe310ef6a411f920", "COMPOSERS", 2000, "2000", false, "Composers clue for 2000 points in column 2, row 4", "", "response 2-4", false, true
+	"super", "5001", "Final Jeopardy", 0, 0, "b14facec2ea3c22d", "FAMOUS NAMES", NULL, "", false, "This scientist gave his name to a unit of radioactivity", "", "Becquerel", false, true
+	"super", "5001", "Jeopardy", 4, 1, "1eb84bd051dc3dd8", "FIRST LADIES", 200, "200", false, "First Ladies clue for 200 points in column 4, row 1", "", "response 4-1", false, true
+	"super", "5001", "Jeopardy", 4, 2, "e01bbad0828d997b", "FIRST LADIES", 400, "400", false, "First Ladies clue for 400 points in column 4, row 2", "", "response 4-2", false, true
+	"super", "5001", "Jeopardy", 4, 3, "7bcc55ab15b811d5", "FIRST LADIES", 600, "600", false, "First Ladies clue for 600 points in column 4, row 3", "", "response 4-3", false, true
+	"super", "5001", "Jeopardy", 4, 4, "aa8b89307eecf039", "FIRST LADIES", 800, "800", false, "First Ladies clue for 800 points in column 4, row 4", "", "response 4-4", false, true
+	"super", "5001", "Jeopardy", 4, 5, "995f195a03e997bc", "FIRST LADIES", 1000, "1000", false, "First Ladies clue for 1000 points in column 4, row 5", "", "response 4-5", false, true
+	"super", "5001", "Double Jeopardy", 5, 1, "b2d675d3e8428cd2", "MYTHOLOGY", 500, "500", false, "Mythology clue for 500 points in column 5, row 1", "", "response 5-1", false, true
+	"super", "5001", "Double Jeopardy", 5, 2, "6d2d4a2d747a0342", "MYTHOLOGY", 1000, "1000", false, "Mythology clue for 1000 points in column 5, row 2", "", "response 5-2", false, true
+	"super", "5001", "Double Jeopardy", 5, 3, "fe7efab779155b2a", "MYTHOLOGY", 1500, "1500", false, "Mythology clue for 1500 points in column 5, row 3", "", "response 5-3", false, true
+	"super", "5001", "Double Jeopardy", 5, 4, "8f38d371b62eb727", "MYTHOLOGY", 2000, "2000", false, "Mythology clue for 2000 points in column 5, row 4", "", "response 5-4", false, true
+	"super", "5001", "Double Jeopardy", 4, 1, "c535d5142a276699", "NOVELS", 500, "500", false, "Novels clue for 500 points in column 4, row 1", "", "response 4-1", false, true
+	"super", "5001", "Double Jeopardy", 4, 2, "14ae4ac3444728e4", "NOVELS", 1000, "1000", false, "Novels clue for 1000 points in column 4, row 2", "", "response 4-2", false, true
+	"super", "5001", "Double Jeopardy", 4, 3, "3c9fd23041a5a3ee", "NOVELS", 1500, "1500", false, "Novels clue for 1500 points in column 4, row 3", "", "response 4-3", false, true
+	"super", "5001", "Double Jeopardy", 4, 4, "00378650ba2f8334", "NOVELS", 2000, "2000", false, "Novels clue for 2000 points in column 4, row 4", "", "response 4-4", false, true
+	"super", "5001", "Jeopardy", 2, 1, "8f4ea3b49c421e9c", "OPERA", 200, "200", false, "Opera clue for 200 points in column 2, row 1", "", "response 2-1", false, true
+	"super", "5001", "Jeopardy", 2, 2, "f855e6fd1ea4c37f", "OPERA", 400, "400", false, "Opera clue for 400 points in column 2, row 2", "", "response 2-2", false, true
+	"super", "5001", "Jeopardy", 2, 3, "ad434fb1b4a92097", "OPERA", 600, "600", false, "Opera clue for 600 points in column 2, row 3", "", "response 2-3", false, true
+	"super", "5001", "Jeopardy", 2, 4, "671595a396a63f38", "OPERA", 800, "800", false, "Opera clue for 800 points in column 2, row 4", "", "response 2-4", false, true
+	"super", "5001", "Jeopardy", 2, 5, "ed2b06b30fb9d6bb", "OPERA", 1000, "1000", false, "Opera clue for 1000 points in column 2, row 5", "", "response 2-5", false, true
+	"super", "5001", "Jeopardy", 5, 1, "c05d3dc8bfe98c57", "POETS", 200, "200", false, "Poets clue for 200 points in column 5, row 1", "", "response 5-1", false, true
+	"super", "5001", "Jeopardy", 5, 2, "b0e762302438b607", "POETS", 400, "400", false, "Poets clue for 400 points in column 5, row 2", "", "response 5-2", false, true
+	"super", "5001", "Jeopardy", 5, 3, "f7dcc30657537282", "POETS", 600, "600", false, "Poets clue for 600 points in column 5, row 3", "", "response 5-3", false, true
+	"super", "5001", "Jeopardy", 5, 4, "babea8b0a8d893c6", "POETS", 800, "800", false, "Poets clue for 800 points in column 5, row 4", "", "response 5-4", false, true
+	"super", "5001", "Jeopardy", 5, 5, "a791323d1ca341e0", "POETS", 1000, "1000", false, "Poets clue for 1000 points in column 5, row 5", "", "response 5-5", false, true
+	"super", "5001", "Jeopardy", 3, 1, "38972335bca1a44d", "RIVERS", 200, "200", false, "Rivers clue for 200 points in column 3, row 1", "", "response 3-1", false, true
+	"super", "5001", "Jeopardy", 3, 2, "e3dcaa8f00d1d8ed", "RIVERS", 400, "400", false, "Rivers clue for 400 points in column 3, row 2", "", "response 3-2", false, true
+	"super", "5001", "Jeopardy", 3, 3, "7e03c428eed6ed0d", "RIVERS", 600, "600", false, "Rivers clue for 600 points in column 3, row 3", "", "response 3-3", false, true
+	"super", "5001", "Jeopardy", 3, 4, "84e7100d338e6187", "RIVERS", 800, "800", false, "Rivers clue for 800 points in column 3, row 4", "", "response 3-4", false, true
+	"super", "5001", "Jeopardy", 3, 5, "42c92902350a47dd", "RIVERS", 1000, "1000", false, "Rivers clue for 1000 points in column 3, row 5", "", "response 3-5", false, true
+	"super", "5001", "Double Jeopardy", 1, 1, "fcb250c4128b34d3", "WORLD HISTORY", 500, "500", false, "World History clue for 500 points in column 1, row 1", "", "response 1-1", false, true
+	"super", "5001", "Double Jeopardy", 1, 2, "4dd5a21e7b4d972c", "WORLD HISTORY", 1000, "1000", false, "World History clue for 1000 points in column 1, row 2", "", "response 1-2", false, true
+	"super", "5001", "Double Jeopardy", 1, 3, "b78b285bf3d3710d", "WORLD HISTORY", 1500, "1500", false, "World History clue for 1500 points in column 1, row 3", "", "response 1-3", false, true
+	"super", "5001", "Double Jeopardy", 1, 4, "356f8578de4c5914", "WORLD HISTORY", 2000, "2000", false, "World History clue for 2000 points in column 1, row 4", "", "response 1-4", false, true
+	"team", "8012", "Double Jeopardy", 1, 1, "76137e08a0ba47c6", "DJ A", 400, "$400", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"team", "8012", "Double Jeopardy", 1, 2, "cbb7bbdb67e97ec2", "DJ A", 800, "$800", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"team", "8012", "Double Jeopardy", 1, 4, "12df6809f456def7", "DJ A", 1600, "$1,600", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"team", "8012", "Double Jeopardy", 1, 5, "945b339e14815382", "DJ A", 2000, "$2,000", false, "DJ clue in column 1, row 5", "", "DJ response 1-5", false, true
+	"team", "8012", "Double Jeopardy", 1, 3, "8cc22ff36b539188", "DJ A", 2400, "DD: $2,400", true, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"team", "8012", "Double Jeopardy", 2, 1, "fe30e30b292e2bb7", "DJ B", 400, "$400", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"team", "8012", "Double Jeopardy", 2, 2, "e5126224b8428912", "DJ B", 800, "$800", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"team", "8012", "Double Jeopardy", 2, 3, "34cac5e65d9a674d", "DJ B", 1200, "$1,200", false, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"team", "8012", "Double Jeopardy", 2, 4, "fedc983e53388415", "DJ B", 1600, "$1,600", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"team", "8012", "Double Jeopardy", 2, 5, "8bae17279579c240", "DJ B", 2000, "$2,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+	"team", "8012", "Double Jeopardy", 3, 1, "aaabde9a03d5b719", "DJ C", 400, "$400", false, "DJ clue in column 3, row 1", "", "DJ response 3-1", false, true
+	"team", "8012", "Double Jeopardy", 3, 2, "0ac3101a8fc49280", "DJ C", 800, "$800", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"team", "8012", "Double Jeopardy", 3, 3, "eeb871b713c498af", "DJ C", 1200, "$1,200", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"team", "8012", "Double Jeopardy", 3, 4, "6c62d679b0569a93", "DJ C", 1600, "$1,600", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"team", "8012", "Double Jeopardy", 3, 5, "baf77a9284f498ed", "DJ C", 2000, "$2,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"team", "8012", "Double Jeopardy", 4, 1, "8a9fcaf9a772de6a", "DJ D", 400, "$400", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"team", "8012", "Double Jeopardy", 4, 2, "8f29702a7dddc11e", "DJ D", 800, "$800", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"team", "8012", "Double Jeopardy", 4, 3, "112c7c261514db96", "DJ D", 1200, "$1,200", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"team", "8012", "Double Jeopardy", 4, 4, "9517655fd42ffd28", "DJ D", 1600, "$1,600", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"team", "8012", "Double Jeopardy", 5, 1, "f7c4fa8d14426e3c", "DJ E", 400, "$400", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"team", "8012", "Double Jeopardy", 5, 2, "7dca06d72290380b", "DJ E", 800, "$800", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"team", "8012", "Double Jeopardy", 5, 3, "34a5bbca1f20cdec", "DJ E", 1200, "$1,200", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"team", "8012", "Double Jeopardy", 5, 4, "4fd6cc6764018749", "DJ E", 1600, "$1,600", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"team", "8012", "Double Jeopardy", 5, 5, "3e117e5febfccc3c", "DJ E", 2000, "$2,000", false, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"team", "8012", "Double Jeopardy", 6, 1, "191e7fa898054022", "DJ F", 400, "$400", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"team", "8012", "Double Jeopardy", 6, 2, "5439384458668baf", "DJ F", 800, "$800", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"team", "8012", "Double Jeopardy", 6, 3, "33edaa8ef472ea28", "DJ F", 1200, "$1,200", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"team", "8012", "Double Jeopardy", 6, 5, "6e48c433c92fd90a", "DJ F", 2000, "$2,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"team", "8012", "Double Jeopardy", 6, 4, "1510afdd6cb214c1", "DJ F", 3200, "DD: $3,200", true, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"team", "8012", "Jeopardy", 1, 1, "6bfc3df7388ca173", "J A", 200, "$200", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"team", "8012", "Jeopardy", 1, 2, "b3251516e40544d7", "J A", 400, "$400", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"team", "8012", "Jeopardy", 1, 3, "330413b4ee1c077e", "J A", 600, "$600", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"team", "8012", "Jeopardy", 1, 4, "4d21aa26102446bb", "J A", 800, "$800", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"team", "8012", "Jeopardy", 1, 5, "ebee3597c498cd73", "J A", 1000, "$1,000", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"team", "8012", "Jeopardy", 2, 1, "e438d9a880917fbf", "J B", 200, "$200", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"team", "8012", "Jeopardy", 2, 2, "5746cf7bbe54543a", "J B", 400, "$400", false, "J clue in column 2, row 2", "", "J response 2-2", false, true
+	"team", "8012", "Jeopardy", 2, 3, "50afa106c788ef48", "J B", 600, "$600", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"team", "8012", "Jeopardy", 2, 5, "e7e6d3e00e224311", "J B", 1000, "$1,000", false, "J clue in column 2, row 5", "", "J response 2-5", false, true
+	"team", "8012", "Jeopardy", 2, 4, "8a64a4d514e09a0c", "J B", 1600, "DD: $1,600", true, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"team", "8012", "Jeopardy", 3, 1, "26aad23963492b82", "J C", 200, "$200", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"team", "8012", "Jeopardy", 3, 2, "f5bc515ebbc4f63d", "J C", 400, "$400", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"team", "8012", "Jeopardy", 3, 3, "f59ec8d5d535221d", "J C", 600, "$600", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"team", "8012", "Jeopardy", 3, 4, "d89639eafc4b19f6", "J C", 800, "$800", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"team", "8012", "Jeopardy", 3, 5, "06ecb68bcc19b4eb", "J C", 1000, "$1,000", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"team", "8012", "Jeopardy", 4, 1, "e4ee367c05e0ac61", "J D", 200, "$200", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"team", "8012", "Jeopardy", 4, 2, "c5d298c7abe8e6ea", "J D", 400, "$400", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"team", "8012", "Jeopardy", 4, 3, "860cef2191c4e89a", "J D", 600, "$600", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"team", "8012", "Jeopardy", 4, 4, "e09df6754fa50c2a", "J D", 800, "$800", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"team", "8012", "Jeopardy", 4, 5, "c0414453f4759c82", "J D", 1000, "$1,000", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"team", "8012", "Jeopardy", 5, 1, "8dd70a9804a26076", "J E", 200, "$200", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"team", "8012", "Jeopardy", 5, 2, "1970cc7b0c0f81ec", "J E", 400, "$400", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"team", "8012", "Jeopardy", 5, 3, "c5d0418ee79d24a7", "J E", 600, "$600", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"team", "8012", "Jeopardy", 5, 4, "f8835408fecd68db", "J E", 800, "$800", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"team", "8012", "Jeopardy", 5, 5, "b4da3fd2beaf2b4c", "J E", 1000, "$1,000", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"team", "8012", "Final Jeopardy", 0, 0, "ba14886b3ede72e0", "U.S. STATES", NULL, "", false, "It's the only state whose name is one syllable", "", "Maine", false, true
+	"tiebreaker", "6000", "Jeopardy", 1, 1, "961f891cb60e7f94", "A", 200, "$200", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 1, 2, "f50f78b484407e79", "A", 400, "$400", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 1, 3, "608a4cb8fff2decc", "A", 600, "$600", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 1, 4, "6089048ffc46dc6d", "A", 800, "$800", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 1, 5, "3448ef9273e3bda1", "A", 1000, "$1,000", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"tiebreaker", "6000", "Tiebreaker", 0, 0, "8f9e12d23c221e72", "AIRPORTS", NULL, "", false, "Chicago's busiest airport is named for this WWII flying ace", "", "O'Hare", false, true
+	"tiebreaker", "6000", "Jeopardy", 2, 1, "5d280e18fedd58f1", "B", 200, "$200", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 2, 2, "885537ad395d4496", "B", 400, "$400", false, "J clue in column 2, row 2", "", "J response 2-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 2, 3, "14582a630c69413e", "B", 600, "$600", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 2, 4, "ca58c3d473078561", "B", 800, "$800", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 2, 5, "b66d78da7feacc6e", "B", 1000, "$1,000", false, "J clue in column 2, row 5", "", "J response 2-5", false, true
+	"tiebreaker", "6000", "Jeopardy", 3, 1, "fecdcbaabafb5c92", "C", 200, "$200", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 3, 2, "052cb0bb64e22e25", "C", 400, "$400", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 3, 3, "294646c6bf13cc31", "C", 600, "$600", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 3, 4, "6a177fecf39e74fd", "C", 800, "$800", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 3, 5, "23963aaa685bd56f", "C", 1000, "$1,000", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"tiebreaker", "6000", "Jeopardy", 4, 1, "02120d9a5248a16b", "D", 200, "$200", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 4, 2, "32afa01693c72a24", "D", 400, "$400", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 4, 3, "ce0f32d8bd8fcc88", "D", 600, "$600", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 4, 4, "cc98d6e4dfab3760", "D", 800, "$800", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 4, 5, "b1e00aa5e35d309f", "D", 1000, "$1,000", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"tiebreaker", "6000", "Jeopardy", 5, 1, "158d38f1687ade69", "E", 200, "$200", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 5, 2, "00f1d12a85d7155a", "E", 400, "$400", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 5, 3, "8cfdf474c442a381", "E", 600, "$600", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 5, 4, "53b3058f53f9e8dc", "E", 800, "$800", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 5, 5, "eeda7cd9ce19c11e", "E", 1000, "$1,000", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"tiebreaker", "6000", "Jeopardy", 6, 1, "274e5d4b2b510b9c", "F", 200, "$200", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"tiebreaker", "6000", "Jeopardy", 6, 2, "d40fab93490794f0", "F", 400, "$400", false, "J clue in column 6, row 2", "", "J response 6-2", false, true
+	"tiebreaker", "6000", "Jeopardy", 6, 3, "2f110cb35f7077fe", "F", 600, "$600", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"tiebreaker", "6000", "Jeopardy", 6, 4, "bd996d0cdd997ab1", "F", 800, "$800", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"tiebreaker", "6000", "Jeopardy", 6, 5, "9c935a0af4e0e624", "F", 1000, "$1,000", false, "J clue in column 6, row 5", "", "J response 6-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 1, 1, "6764fcecc537593b", "G", 400, "$400", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 1, 2, "d238993b147b0188", "G", 800, "$800", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 1, 3, "1efafa4fe6321293", "G", 1200, "$1,200", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 1, 4, "ffe57eca2b9e5873", "G", 1600, "$1,600", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 1, 5, "cdcd3bb1b56a3f48", "G", 2000, "$2,000", false, "DJ clue in column 1, row 5", "", "DJ response 1-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 2, 1, "4507c4e7c5d32b0a", "H", 400, "$400", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 2, 2, "78a875ab3649c3e4", "H", 800, "$800", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 2, 3, "c1c30bb6ac602f1d", "H", 1200, "$1,200", false, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 2, 4, "738be86d222ca8aa", "H", 1600, "$1,600", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 2, 5, "6e46d84e9b9ff9e3", "H", 2000, "$2,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 3, 1, "8618fb15aef4de84", "I", 400, "$400", false, "DJ clue in column 3, row 1", "", "DJ response 3-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 3, 2, "4d84e39e87a02a0f", "I", 800, "$800", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 3, 3, "bc3afd3d792b93d6", "I", 1200, "$1,200", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 3, 4, "cd6d0c0db1eb9b86", "I", 1600, "$1,600", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 3, 5, "81a258bf9e31b7e1", "I", 2000, "$2,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 4, 1, "56812c70bf43a587", "J", 400, "$400", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 4, 2, "e1c1da579929e5f5", "J", 800, "$800", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 4, 3, "1d0f369f5fdd1e50", "J", 1200, "$1,200", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 4, 4, "400607ac09cab35b", "J", 1600, "$1,600", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 4, 5, "41e37bccd027dab3", "J", 2000, "$2,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 5, 1, "4f2d43c969e42204", "K", 400, "$400", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 5, 2, "244456734e0c403a", "K", 800, "$800", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 5, 3, "6f89523f46ed240f", "K", 1200, "$1,200", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 5, 4, "8380882e780dda14", "K", 1600, "$1,600", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 5, 5, "8b920cd07c33ab9e", "K", 2000, "$2,000", false, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 6, 1, "0eeb3a5085801cee", "L", 400, "$400", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 6, 2, "8f6c66ee71d685a9", "L", 800, "$800", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 6, 3, "a4b4deb1baf155b5", "L", 1200, "$1,200", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 6, 4, "26842a7fe872a3f1", "L", 1600, "$1,600", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"tiebreaker", "6000", "Double Jeopardy", 6, 5, "4b82412db3064125", "L", 2000, "$2,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"tiebreaker", "6000", "Final Jeopardy", 0, 0, "8b0db4225fed7d80", "MOUNTAINS", NULL, "", false, "It's the highest peak in Africa", "", "Kilimanjaro", false, true
+	"tournament", "8965", "Double Jeopardy", 3, 1, "e767d1a9d7939f0a", "BALLET", 400, "$400", false, "DJ clue in column 3, row 1", "", "DJ response 3-1", false, true
+	"tournament", "8965", "Double Jeopardy", 3, 2, "068745fc3105fa79", "BALLET", 800, "$800", false, "DJ clue in column 3, row 2", "", "DJ response 3-2", false, true
+	"tournament", "8965", "Double Jeopardy", 3, 3, "939ab270ae0977d3", "BALLET", 1200, "$1,200", false, "DJ clue in column 3, row 3", "", "DJ response 3-3", false, true
+	"tournament", "8965", "Double Jeopardy", 3, 4, "526bd8b0a3ad0006", "BALLET", 1600, "$1,600", false, "DJ clue in column 3, row 4", "", "DJ response 3-4", false, true
+	"tournament", "8965", "Double Jeopardy", 3, 5, "c393c51475041769", "BALLET", 2000, "$2,000", false, "DJ clue in column 3, row 5", "", "DJ response 3-5", false, true
+	"tournament", "8965", "Jeopardy", 6, 1, "8921388ce2aa787d", "CHESS", 200, "$200", false, "J clue in column 6, row 1", "", "J response 6-1", false, true
+	"tournament", "8965", "Jeopardy", 6, 2, "96c971a3cce395e0", "CHESS", 400, "$400", false, "J clue in column 6, row 2", "", "J response 6-2", false, true
+	"tournament", "8965", "Jeopardy", 6, 3, "79b922dd962253b7", "CHESS", 600, "$600", false, "J clue in column 6, row 3", "", "J response 6-3", false, true
+	"tournament", "8965", "Jeopardy", 6, 4, "0f6b38fa80d03232", "CHESS", 800, "$800", false, "J clue in column 6, row 4", "", "J response 6-4", false, true
+	"tournament", "8965", "Jeopardy", 6, 5, "99614a0093b1ef27", "CHESS", 1000, "$1,000", false, "J clue in column 6, row 5", "", "J response 6-5", false, true
+	"tournament", "8965", "Double Jeopardy", 4, 1, "a41fc7cfff585056", "CODES", 400, "$400", false, "DJ clue in column 4, row 1", "", "DJ response 4-1", false, true
+	"tournament", "8965", "Double Jeopardy", 4, 2, "ffcda46911220cbc", "CODES", 800, "$800", false, "DJ clue in column 4, row 2", "", "DJ response 4-2", false, true
+	"tournament", "8965", "Double Jeopardy", 4, 3, "35184f76afc86590", "CODES", 1200, "$1,200", false, "DJ clue in column 4, row 3", "", "DJ response 4-3", false, true
+	"tournament", "8965", "Double Jeopardy", 4, 4, "1b1845b50be99d2a", "CODES", 1600, "$1,600", false, "DJ clue in column 4, row 4", "", "DJ response 4-4", false, true
+	"tournament", "8965", "Double Jeopardy", 4, 5, "fd00ee0de11fe285", "CODES", 2000, "$2,000", false, "DJ clue in column 4, row 5", "", "DJ response 4-5", false, true
+	"tournament", "8965", "Jeopardy", 3, 1, "17aef926c9e132ca", "COMPOSERS", 200, "$200", false, "J clue in column 3, row 1", "", "J response 3-1", false, true
+	"tournament", "8965", "Jeopardy", 3, 2, "8b7c51f940647771", "COMPOSERS", 400, "$400", false, "J clue in column 3, row 2", "", "J response 3-2", false, true
+	"tournament", "8965", "Jeopardy", 3, 3, "03301eced8bf6b4d", "COMPOSERS", 600, "$600", false, "J clue in column 3, row 3", "", "J response 3-3", false, true
+	"tournament", "8965", "Jeopardy", 3, 4, "6cdccfcc24fb844f", "COMPOSERS", 800, "$800", false, "J clue in column 3, row 4", "", "J response 3-4", false, true
+	"tournament", "8965", "Jeopardy", 3, 5, "83a2eea9ee0bb820", "COMPOSERS", 1000, "$1,000", false, "J clue in column 3, row 5", "", "J response 3-5", false, true
+	"tournament", "8965", "Jeopardy", 2, 1, "7205f76c6f7d5f8f", "ELEMENTS", 200, "$200", false, "J clue in column 2, row 1", "", "J response 2-1", false, true
+	"tournament", "8965", "Jeopardy", 2, 2, "00f8b4c890456795", "ELEMENTS", 400, "$400", false, "J clue in column 2, row 2", "", "J response 2-2", false, true
+	"tournament", "8965", "Jeopardy", 2, 3, "b115646e75fe0ea2", "ELEMENTS", 600, "$600", false, "J clue in column 2, row 3", "", "J response 2-3", false, true
+	"tournament", "8965", "Jeopardy", 2, 4, "dc9e83076101e46f", "ELEMENTS", 800, "$800", false, "J clue in column 2, row 4", "", "J response 2-4", false, true
+	"tournament", "8965", "Jeopardy", 2, 5, "3b883178bcf49537", "ELEMENTS", 1000, "$1,000", false, "J clue in column 2, row 5", "", "J response 2-5", false, true
+	"tournament", "8965", "Jeopardy", 1, 1, "8fce162da19b1417", "MYTHOLOGY", 200, "$200", false, "J clue in column 1, row 1", "", "J response 1-1", false, true
+	"tournament", "8965", "Jeopardy", 1, 2, "6f7a7237b86d2602", "MYTHOLOGY", 400, "$400", false, "J clue in column 1, row 2", "", "J response 1-2", false, true
+	"tournament", "8965", "Jeopardy", 1, 3, "7b006732a31004fb", "MYTHOLOGY", 600, "$600", false, "J clue in column 1, row 3", "", "J response 1-3", false, true
+	"tournament", "8965", "Jeopardy", 1, 4, "2802efd52e722a9b", "MYTHOLOGY", 800, "$800", false, "J clue in column 1, row 4", "", "J response 1-4", false, true
+	"tournament", "8965", "Jeopardy", 1, 5, "685029461a514e06", "MYTHOLOGY", 1000, "$1,000", false, "J clue in column 1, row 5", "", "J response 1-5", false, true
+	"tournament", "8965", "Double Jeopardy", 6, 1, "2963b7edf55663b6", "NOBEL", 400, "$400", false, "DJ clue in column 6, row 1", "", "DJ response 6-1", false, true
+	"tournament", "8965", "Double Jeopardy", 6, 2, "11f5b680049e3910", "NOBEL", 800, "$800", false, "DJ clue in column 6, row 2", "", "DJ response 6-2", false, true
+	"tournament", "8965", "Double Jeopardy", 6, 3, "a8f99d15e3123411", "NOBEL", 1200, "$1,200", false, "DJ clue in column 6, row 3", "", "DJ response 6-3", false, true
+	"tournament", "8965", "Double Jeopardy", 6, 4, "6567911659acd28e", "NOBEL", 1600, "$1,600", false, "DJ clue in column 6, row 4", "", "DJ response 6-4", false, true
+	"tournament", "8965", "Double Jeopardy", 6, 5, "ee60f30d436eaca7", "NOBEL", 2000, "$2,000", false, "DJ clue in column 6, row 5", "", "DJ response 6-5", false, true
+	"tournament", "8965", "Jeopardy", 5, 1, "98187058b7b81583", "NOVELS", 200, "$200", false, "J clue in column 5, row 1", "", "J response 5-1", false, true
+	"tournament", "8965", "Jeopardy", 5, 2, "b00490de3820c7af", "NOVELS", 400, "$400", false, "J clue in column 5, row 2", "", "J response 5-2", false, true
+	"tournament", "8965", "Jeopardy", 5, 3, "f5059cf5aed822da", "NOVELS", 600, "$600", false, "J clue in column 5, row 3", "", "J response 5-3", false, true
+	"tournament", "8965", "Jeopardy", 5, 4, "1afec85c9e6021ed", "NOVELS", 800, "$800", false, "J clue in column 5, row 4", "", "J response 5-4", false, true
+	"tournament", "8965", "Jeopardy", 5, 5, "47ab5cb1e86c8e5c", "NOVELS", 1000, "$1,000", false, "J clue in column 5, row 5", "", "J response 5-5", false, true
+	"tournament", "8965", "Double Jeopardy", 5, 1, "c3c5839d151d50f3", "ORBITS", 400, "$400", false, "DJ clue in column 5, row 1", "", "DJ response 5-1", false, true
+	"tournament", "8965", "Double Jeopardy", 5, 2, "5df91a5b93182d61", "ORBITS", 800, "$800", false, "DJ clue in column 5, row 2", "", "DJ response 5-2", false, true
+	"tournament", "8965", "Double Jeopardy", 5, 3, "0f71d08c9a958369", "ORBITS", 1200, "$1,200", false, "DJ clue in column 5, row 3", "", "DJ response 5-3", false, true
+	"tournament", "8965", "Double Jeopardy", 5, 4, "40a0b104628febf7", "ORBITS", 1600, "$1,600", false, "DJ clue in column 5, row 4", "", "DJ response 5-4", false, true
+	"tournament", "8965", "Double Jeopardy", 5, 5, "372f458fa8181e49", "ORBITS", 2000, "$2,000", false, "DJ clue in column 5, row 5", "", "DJ response 5-5", false, true
+	"tournament", "8965", "Double Jeopardy", 1, 1, "72cafa3f646ee4ba", "PHILOSOPHY", 400, "$400", false, "DJ clue in column 1, row 1", "", "DJ response 1-1", false, true
+	"tournament", "8965", "Double Jeopardy", 1, 2, "168aad145ebe257c", "PHILOSOPHY", 800, "$800", false, "DJ clue in column 1, row 2", "", "DJ response 1-2", false, true
+	"tournament", "8965", "Double Jeopardy", 1, 3, "1278b955b296ebd2", "PHILOSOPHY", 1200, "$1,200", false, "DJ clue in column 1, row 3", "", "DJ response 1-3", false, true
+	"tournament", "8965", "Double Jeopardy", 1, 4, "a61b63f3d0676bbd", "PHILOSOPHY", 1600, "$1,600", false, "DJ clue in column 1, row 4", "", "DJ response 1-4", false, true
+	"tournament", "8965", "Double Jeopardy", 1, 5, "5733a639d1b6f021", "PHILOSOPHY", 2000, "$2,000", false, "DJ clue in column 1, row 5", "", "DJ response 1-5", false, true
+	"tournament", "8965", "Jeopardy", 4, 1, "4982e1afe963e630", "RIVERS", 200, "$200", false, "J clue in column 4, row 1", "", "J response 4-1", false, true
+	"tournament", "8965", "Jeopardy", 4, 2, "ba5da1e78241b057", "RIVERS", 400, "$400", false, "J clue in column 4, row 2", "", "J response 4-2", false, true
+	"tournament", "8965", "Jeopardy", 4, 3, "8f0ea44d3a28b618", "RIVERS", 600, "$600", false, "J clue in column 4, row 3", "", "J response 4-3", false, true
+	"tournament", "8965", "Jeopardy", 4, 4, "198f04055b18e448", "RIVERS", 800, "$800", false, "J clue in column 4, row 4", "", "J response 4-4", false, true
+	"tournament", "8965", "Jeopardy", 4, 5, "bea12ddc48e97fcb", "RIVERS", 1000, "$1,000", false, "J clue in column 4, row 5", "", "J response 4-5", false, true
+	"tournament", "8965", "Final Jeopardy", 0, 0, "e129944ca5d7db2a", "THE 20TH CENTURY", NULL, "", false, "This treaty ended World War I", "", "the Treaty of Versailles", false, true
+	"tournament", "8965", "Double Jeopardy", 2, 1, "8319b71896d59604", "TREATIES", 400, "$400", false, "DJ clue in column 2, row 1", "", "DJ response 2-1", false, true
+	"tournament", "8965", "Double Jeopardy", 2, 2, "b9962816aae49c9c", "TREATIES", 800, "$800", false, "DJ clue in column 2, row 2", "", "DJ response 2-2", false, true
+	"tournament", "8965", "Double Jeopardy", 2, 3, "c8d42a527b5993f9", "TREATIES", 1200, "$1,200", false, "DJ clue in column 2, row 3", "", "DJ response 2-3", false, true
+	"tournament", "8965", "Double Jeopardy", 2, 4, "cc67360578e732ee", "TREATIES", 1600, "$1,600", false, "DJ clue in column 2, row 4", "", "DJ response 2-4", false, true
+	"tournament", "8965", "Double Jeopardy", 2, 5, "70d1dc3bed5aa4a1", "TREATIES", 2000, "$2,000", false, "DJ clue in column 2, row 5", "", "DJ response 2-5", false, true
+COMMIT
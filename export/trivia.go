@@ -0,0 +1,97 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// TriviaQuestion is a clue in the shape of Open Trivia DB's api.php
+// results, which many quiz frontends read
+type TriviaQuestion struct {
+	// "multiple" with incorrect answers, "text" without
+	Type string `json:"type"`
+	// easy, medium or hard, from jarchive.Clue.Difficulty
+	Difficulty       string   `json:"difficulty"`
+	Category         string   `json:"category"`
+	Question         string   `json:"question"`
+	CorrectAnswer    string   `json:"correct_answer"`
+	IncorrectAnswers []string `json:"incorrect_answers"`
+	// not in the API's results: the clue's stable ID, the clue_id column
+	ClueID string `json:"clue_id"`
+}
+
+// triviaResponse is the whole file, an api.php response
+type triviaResponse struct {
+	ResponseCode int              `json:"response_code"`
+	Results      []TriviaQuestion `json:"results"`
+}
+
+// writes clues as an Open Trivia DB api.php response: a response_code of
+// 0 and the results, one per revealed clue. Each question gets up to
+// incorrect wrong answers, taken from the other correct responses in its
+// category of the same game so they fit the question; with 0 they are left
+// empty. Text is plain rather than HTML-encoded as the API's is.
+func WriteTrivia(w io.Writer, clues []dataset.Clue, incorrect int) error {
+	res := triviaResponse{Results: []TriviaQuestion{}}
+	// a game's clues are next to each other, as the CSVs list them
+	for start := 0; start < len(clues); {
+		end := start + 1
+		for end < len(clues) && clues[end].Season == clues[start].Season && clues[end].EpisodeNumber == clues[start].EpisodeNumber {
+			end++
+		}
+		game := clues[start:end]
+		for i := range game {
+			c := &game[i]
+			if !c.Revealed || c.Question == "" || c.Answer == "" {
+				continue
+			}
+			q := TriviaQuestion{Type: "text", Difficulty: triviaDifficulty(c), Category: c.Category,
+				Question: c.Question, CorrectAnswer: c.Answer, IncorrectAnswers: triviaIncorrect(game, i, incorrect), ClueID: c.ID()}
+			if len(q.IncorrectAnswers) > 0 {
+				q.Type = "multiple"
+			}
+			res.Results = append(res.Results, q)
+		}
+		start = end
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+// buckets a clue's difficulty grade into easy, medium and hard
+func triviaDifficulty(c *dataset.Clue) string {
+	d, _ := c.Difficulty(c.AirDate)
+	switch {
+	case d < 0.25:
+		return "easy"
+	case d < 0.5:
+		return "medium"
+	}
+	return "hard"
+}
+
+// returns up to n of the correct responses to the other clues in game[i]'s
+// category and round, leaving out any that would count as the right answer
+func triviaIncorrect(game []dataset.Clue, i, n int) []string {
+	c := &game[i]
+	out := []string{}
+	seen := map[string]bool{jarchive.NormalizeAnswer(c.Answer): true}
+	for j := range game {
+		o := &game[j]
+		if len(out) == n {
+			break
+		}
+		if j == i || o.Answer == "" || o.Round != c.Round || o.Category != c.Category {
+			continue
+		}
+		if key := jarchive.NormalizeAnswer(o.Answer); !seen[key] && !jarchive.MatchesAnswer(o.Answer, c.Answer) {
+			seen[key] = true
+			out = append(out, o.Answer)
+		}
+	}
+	return out
+}
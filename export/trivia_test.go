@@ -0,0 +1,42 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/internal/golden"
+	"j-parser-go/jarchive"
+)
+
+// compares the golden clues' Open Trivia DB response, with no wrong answers
+// and with export's default of 3, with testdata/trivia.<n>.golden.json
+func TestGoldenTrivia(t *testing.T) {
+	clues := goldenClues(t)
+	for _, incorrect := range []int{0, 3} {
+		t.Run(fmt.Sprint(incorrect), func(t *testing.T) {
+			var got bytes.Buffer
+			if err := WriteTrivia(&got, clues, incorrect); err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", fmt.Sprintf("trivia.%d.golden.json", incorrect)), got.Bytes())
+
+			var res triviaResponse
+			if err := json.Unmarshal(got.Bytes(), &res); err != nil {
+				t.Fatal(err)
+			}
+			for _, q := range res.Results {
+				if len(q.IncorrectAnswers) > incorrect {
+					t.Errorf("clue %s: %d wrong answers, want at most %d", q.ClueID, len(q.IncorrectAnswers), incorrect)
+				}
+				for _, a := range q.IncorrectAnswers {
+					if jarchive.MatchesAnswer(a, q.CorrectAnswer) {
+						t.Errorf("clue %s: wrong answer %q matches the correct one %q", q.ClueID, a, q.CorrectAnswer)
+					}
+				}
+			}
+		})
+	}
+}
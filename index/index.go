@@ -0,0 +1,349 @@
+// Package index keeps a persistent SQLite full-text index of the parsed
+// clues, so repeated searches don't have to read every CSV again. It is
+// updated incrementally: only seasons whose CSV changed are re-indexed.
+package index
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	_ "modernc.org/sqlite"
+
+	"j-parser-go/dataset"
+	"j-parser-go/parse"
+)
+
+var (
+	csvFolder = "parsed-csv"
+	indexFile = "jarchive-index.db"
+)
+
+// bumped whenever the schema changes; an index with another version is
+// rebuilt from scratch
+const schemaVersion = 6
+
+var schema = []string{
+	`CREATE TABLE seasons (
+		season TEXT PRIMARY KEY,
+		csv_size INTEGER NOT NULL,
+		csv_mod_time INTEGER NOT NULL,
+		clues INTEGER NOT NULL
+	)`,
+	`CREATE TABLE clues (
+		id INTEGER PRIMARY KEY,
+		season TEXT NOT NULL,
+		ord INTEGER NOT NULL,
+		game_id TEXT NOT NULL,
+		ep_num TEXT NOT NULL,
+		air_date TEXT NOT NULL,
+		round TEXT NOT NULL,
+		category TEXT NOT NULL,
+		value INTEGER NOT NULL,
+		value_raw TEXT NOT NULL,
+		daily_double INTEGER NOT NULL,
+		board_column INTEGER NOT NULL,
+		board_row INTEGER NOT NULL,
+		question TEXT NOT NULL,
+		clue_notes TEXT NOT NULL,
+		answer TEXT NOT NULL,
+		triple_stumper INTEGER NOT NULL,
+		tournament TEXT NOT NULL,
+		tournament_stage TEXT NOT NULL,
+		tournament_game INTEGER NOT NULL,
+		host TEXT NOT NULL,
+		game_format TEXT NOT NULL,
+		revealed INTEGER NOT NULL
+	)`,
+	`CREATE INDEX clues_season ON clues (season, ord)`,
+	`CREATE VIRTUAL TABLE clues_fts USING fts5 (question, answer, category, tokenize = 'unicode61 remove_diacritics 2')`,
+}
+
+// Options controls where Build reads the CSVs and writes the index
+type Options struct {
+	// directory holding the season CSVs, "parsed-csv" if empty
+	CSVDir string
+	// the index database, "jarchive-index.db" if empty
+	Path string
+	// re-index every season even if its CSV hasn't changed
+	Rebuild bool
+}
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.CSVDir == "" {
+		o.CSVDir = csvFolder
+	}
+	if o.Path == "" {
+		o.Path = indexFile
+	}
+}
+
+// BuildResult is what Build did
+type BuildResult struct {
+	// seasons re-indexed because their CSV is new or changed
+	Indexed []string
+	// seasons whose CSV hasn't changed since the last build
+	Unchanged []string
+	// seasons dropped because their CSV is gone
+	Removed []string
+	// clues in the index afterwards
+	Clues int
+}
+
+// seasonFile is a season CSV and the size and modification time it had
+type seasonFile struct {
+	season  string
+	path    string
+	size    int64
+	modTime int64
+}
+
+// brings the index at opts.Path up to date with the CSVs in opts.CSVDir,
+// creating it if needed
+func Build(opts Options) (*BuildResult, error) {
+	opts.setDefaults()
+	db, err := openDB(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	files, err := seasonFiles(opts.CSVDir)
+	if err != nil {
+		return nil, err
+	}
+	changed, res, err := plan(db, files, opts.Rebuild)
+	if err != nil {
+		return nil, err
+	}
+	for _, season := range res.Removed {
+		if err := removeSeason(db, season); err != nil {
+			return nil, err
+		}
+		slog.Info("removed season from index", "season", season)
+	}
+	newlines := dataset.Newlines(opts.CSVDir)
+	for _, f := range changed {
+		n, err := indexSeason(db, f, newlines)
+		if err != nil {
+			return nil, err
+		}
+		slog.Info("indexed season", "season", f.season, "clues", n)
+	}
+	if err := db.QueryRow(`SELECT count(*) FROM clues`).Scan(&res.Clues); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// returns what Build would do without changing the index
+func PlanBuild(opts Options) (*BuildResult, error) {
+	opts.setDefaults()
+	files, err := seasonFiles(opts.CSVDir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(opts.Path); errors.Is(err, os.ErrNotExist) {
+		res := &BuildResult{}
+		for _, f := range files {
+			res.Indexed = append(res.Indexed, f.season)
+		}
+		return res, nil
+	}
+	db, err := openDB(opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+	_, res, err := plan(db, files, opts.Rebuild)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.QueryRow(`SELECT count(*) FROM clues`).Scan(&res.Clues); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// opens the index, creating the schema if the database is new or was made
+// by another version
+func openDB(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening index %s: %v", path, err)
+	}
+	// one connection: SQLite serializes writers and this keeps the
+	// transactions below simple
+	db.SetMaxOpenConns(1)
+	var version int
+	if err := db.QueryRow(`PRAGMA user_version`).Scan(&version); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error reading index %s: %v", path, err)
+	}
+	if version == schemaVersion {
+		return db, nil
+	}
+	if version != 0 {
+		slog.Warn("index was built by another version, rebuilding", "file", path, "version", version)
+	}
+	for _, table := range []string{"clues_fts", "clues", "seasons"} {
+		if _, err := db.Exec(`DROP TABLE IF EXISTS ` + table); err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	for _, stmt := range schema {
+		if _, err := db.Exec(stmt); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("error creating index %s: %v", path, err)
+		}
+	}
+	if _, err := db.Exec(fmt.Sprintf(`PRAGMA user_version = %d`, schemaVersion)); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// lists the season CSVs in dir with their sizes and modification times
+func seasonFiles(dir string) ([]seasonFile, error) {
+	seasons, err := dataset.Seasons(dataset.Options{Dir: dir})
+	if err != nil {
+		return nil, err
+	}
+	var files []seasonFile
+	for _, season := range seasons {
+		path := parse.CSVPath(parse.Options{OutDir: dir}, season)
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, seasonFile{season: season, path: path, size: info.Size(), modTime: info.ModTime().UnixNano()})
+	}
+	return files, nil
+}
+
+// compares the CSVs with what the index was built from and returns the
+// files to re-index along with the result so far
+func plan(db *sql.DB, files []seasonFile, rebuild bool) ([]seasonFile, *BuildResult, error) {
+	type indexed struct{ size, modTime int64 }
+	known := make(map[string]indexed)
+	rows, err := db.Query(`SELECT season, csv_size, csv_mod_time FROM seasons`)
+	if err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		var season string
+		var st indexed
+		if err := rows.Scan(&season, &st.size, &st.modTime); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		known[season] = st
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	res := &BuildResult{}
+	var changed []seasonFile
+	for _, f := range files {
+		st, ok := known[f.season]
+		delete(known, f.season)
+		if ok && !rebuild && st.size == f.size && st.modTime == f.modTime {
+			res.Unchanged = append(res.Unchanged, f.season)
+			continue
+		}
+		changed = append(changed, f)
+		res.Indexed = append(res.Indexed, f.season)
+	}
+	for season := range known {
+		res.Removed = append(res.Removed, season)
+	}
+	sortSeasons(res.Removed)
+	return changed, res, nil
+}
+
+// replaces a season's clues with the rows of its CSV, written with the
+// newline mode newlines, in one transaction
+func indexSeason(db *sql.DB, f seasonFile, newlines string) (int, error) {
+	in, err := os.Open(f.path)
+	if err != nil {
+		return 0, err
+	}
+	clues, err := dataset.ReadNewlines(in, f.season, newlines)
+	in.Close()
+	if err != nil {
+		return 0, fmt.Errorf("error reading %s: %v", f.path, err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if err := deleteSeason(tx, f.season); err != nil {
+		return 0, err
+	}
+	insertClue, err := tx.Prepare(`INSERT INTO clues (season, ord, game_id, ep_num, air_date, round, category, value, value_raw,
+		daily_double, board_column, board_row, question, clue_notes, answer, triple_stumper, tournament, tournament_stage, tournament_game, host, game_format, revealed)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return 0, err
+	}
+	defer insertClue.Close()
+	insertText, err := tx.Prepare(`INSERT INTO clues_fts (rowid, question, answer, category) VALUES (?, ?, ?, ?)`)
+	if err != nil {
+		return 0, err
+	}
+	defer insertText.Close()
+	for i, c := range clues {
+		res, err := insertClue.Exec(c.Season, i, c.GameID, c.EpisodeNumber, c.AirDate, c.Round, c.Category, c.Value, c.ValueRaw,
+			c.DailyDouble, c.Column, c.Row, c.Question, c.Notes, c.Answer, c.TripleStumper, c.Tournament, c.TournamentStage, c.TournamentGame, c.Host, c.Format, c.Revealed)
+		if err != nil {
+			return 0, err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return 0, err
+		}
+		if _, err := insertText.Exec(id, c.Question, c.Answer, c.Category); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := tx.Exec(`INSERT INTO seasons (season, csv_size, csv_mod_time, clues) VALUES (?, ?, ?, ?)`,
+		f.season, f.size, f.modTime, len(clues)); err != nil {
+		return 0, err
+	}
+	return len(clues), tx.Commit()
+}
+
+// drops a season from the index
+func removeSeason(db *sql.DB, season string) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	if err := deleteSeason(tx, season); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func deleteSeason(tx *sql.Tx, season string) error {
+	for _, stmt := range []string{
+		`DELETE FROM clues_fts WHERE rowid IN (SELECT id FROM clues WHERE season = ?)`,
+		`DELETE FROM clues WHERE season = ?`,
+		`DELETE FROM seasons WHERE season = ?`,
+	} {
+		if _, err := tx.Exec(stmt, season); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package index
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/dataset"
+	"j-parser-go/internal/golden"
+	"j-parser-go/jarchive"
+	"j-parser-go/search"
+)
+
+// indexes each of the parse package's golden CSVs as a season into an
+// in-memory database, checks that searches find what search.Search finds
+// in the CSVs, in the same order, then that a second build only drops the
+// season whose CSV is gone
+func TestIndexSearch(t *testing.T) {
+	csvDir := golden.Seasons(t)
+	db, err := openDB(":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	files, err := seasonFiles(csvDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, res, err := plan(db, files, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != len(files) || len(res.Unchanged) != 0 {
+		t.Fatalf("first build: %d of %d seasons to index, %d unchanged", len(changed), len(files), len(res.Unchanged))
+	}
+	for _, f := range changed {
+		if _, err := indexSeason(db, f, dataset.Newlines(csvDir)); err != nil {
+			t.Fatalf("season %s: %v", f.season, err)
+		}
+	}
+
+	clues, err := dataset.Load(dataset.Options{Dir: csvDir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ix := &Index{db: db}
+	for _, q := range []search.Query{
+		{Text: "response"},
+		{Text: "clue row", Fields: []string{search.FieldQuestion}},
+		{Category: "rivers"},
+		{Seasons: []string{"old-era", "regular"}, MinValue: 800},
+		{Rounds: []string{jarchive.RoundFinalJeopardy, jarchive.RoundTiebreaker}},
+		{DailyDouble: true},
+		{TripleStumper: true, Limit: 5},
+	} {
+		got, err := ix.Search(q)
+		if err != nil {
+			t.Fatalf("%+v: %v", q, err)
+		}
+		want := search.Search(clues, q)
+		if len(want) == 0 {
+			t.Errorf("%+v: search.Search finds nothing; the query tests nothing", q)
+		}
+		if ids(got) != ids(want) {
+			t.Errorf("%+v: got %d clues, want %d:\n got %s\nwant %s", q, len(got), len(want), ids(got), ids(want))
+		}
+	}
+
+	if err := os.Remove(filepath.Join(csvDir, "j-archive-season-team.csv")); err != nil {
+		t.Fatal(err)
+	}
+	files, err = seasonFiles(csvDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, res, err = plan(db, files, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 0 || len(res.Unchanged) != len(files) || len(res.Removed) != 1 || res.Removed[0] != "team" {
+		t.Errorf("second build: %d to index, %d unchanged and removed %v; want 0, %d and [team]", len(changed), len(res.Unchanged), res.Removed, len(files))
+	}
+}
+
+// the clues' IDs, in order, in one string
+func ids(clues []dataset.Clue) string {
+	s := ""
+	for i := range clues {
+		s += clues[i].ID() + " "
+	}
+	return s
+}
@@ -0,0 +1,180 @@
+package index
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/download"
+	"j-parser-go/search"
+)
+
+// Index is an open index for searching
+type Index struct {
+	db *sql.DB
+}
+
+// opens an index made by Build for searching
+func Open(path string) (*Index, error) {
+	if path == "" {
+		path = indexFile
+	}
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("no index at %s; run jarchive index first", path)
+		}
+		return nil, err
+	}
+	db, err := openDB(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Index{db: db}, nil
+}
+
+func (ix *Index) Close() error {
+	return ix.db.Close()
+}
+
+// returns the clues matching q in the same order search.Search would. Words
+// are matched as whole words or word prefixes rather than anywhere inside
+// a word, so "potable" finds "potables" but "otable" finds nothing.
+func (ix *Index) Search(q search.Query) ([]dataset.Clue, error) {
+	var where []string
+	var args []any
+	from := `clues c`
+	if match := matchExpr(q); match != "" {
+		from = `clues_fts f JOIN clues c ON c.id = f.rowid`
+		where = append(where, `clues_fts MATCH ?`)
+		args = append(args, match)
+	}
+	where = append(where, `c.revealed`)
+	if len(q.Seasons) > 0 {
+		where = append(where, `c.season IN (`+placeholders(len(q.Seasons))+`)`)
+		for _, s := range q.Seasons {
+			args = append(args, s)
+		}
+	}
+	if q.Category != "" {
+		where = append(where, `c.category = ? COLLATE NOCASE`)
+		args = append(args, q.Category)
+	}
+	if len(q.Rounds) > 0 {
+		where = append(where, `c.round IN (`+placeholders(len(q.Rounds))+`)`)
+		for _, r := range q.Rounds {
+			round, err := search.ParseRound(r)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, round)
+		}
+	}
+	if q.MinValue > 0 {
+		where = append(where, `c.value != 0 AND c.value >= ?`)
+		args = append(args, q.MinValue)
+	}
+	if q.MaxValue > 0 {
+		where = append(where, `c.value != 0 AND c.value <= ?`)
+		args = append(args, q.MaxValue)
+	}
+	if q.After != "" {
+		where = append(where, `c.air_date != '' AND c.air_date >= ?`)
+		args = append(args, q.After)
+	}
+	if q.Before != "" {
+		where = append(where, `c.air_date != '' AND c.air_date <= ?`)
+		args = append(args, q.Before)
+	}
+	if q.DailyDouble {
+		where = append(where, `c.daily_double`)
+	}
+	if q.TripleStumper {
+		where = append(where, `c.triple_stumper`)
+	}
+
+	rows, err := ix.db.Query(`SELECT c.season, c.ord, c.game_id, c.ep_num, c.air_date, c.round, c.category, c.value, c.value_raw,
+		c.daily_double, c.board_column, c.board_row, c.question, c.clue_notes, c.answer, c.triple_stumper,
+		c.tournament, c.tournament_stage, c.tournament_game, c.host, c.game_format, c.revealed
+		FROM `+from+` WHERE `+strings.Join(where, ` AND `), args...)
+	if err != nil {
+		return nil, fmt.Errorf("error searching index: %v", err)
+	}
+	defer rows.Close()
+	type hit struct {
+		ord  int
+		clue dataset.Clue
+	}
+	// SQLite has no regular expressions, so that filter is applied here
+	var category *regexp.Regexp
+	if q.CategoryRegex != "" {
+		if category, err = regexp.Compile(q.CategoryRegex); err != nil {
+			return nil, err
+		}
+	}
+	var hits []hit
+	for rows.Next() {
+		var h hit
+		c := &h.clue
+		if err := rows.Scan(&c.Season, &h.ord, &c.GameID, &c.EpisodeNumber, &c.AirDate, &c.Round, &c.Category, &c.Value, &c.ValueRaw,
+			&c.DailyDouble, &c.Column, &c.Row, &c.Question, &c.Notes, &c.Answer, &c.TripleStumper,
+			&c.Tournament, &c.TournamentStage, &c.TournamentGame, &c.Host, &c.Format, &c.Revealed); err != nil {
+			return nil, err
+		}
+		if category != nil && !category.MatchString(c.Category) {
+			continue
+		}
+		hits = append(hits, h)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// CSV order: seasons in season order, rows as written
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].clue.Season != hits[j].clue.Season {
+			return download.SeasonLess(hits[i].clue.Season, hits[j].clue.Season)
+		}
+		return hits[i].ord < hits[j].ord
+	})
+	var clues []dataset.Clue
+	for _, h := range hits {
+		if q.Limit > 0 && len(clues) == q.Limit {
+			break
+		}
+		clues = append(clues, h.clue)
+	}
+	return clues, nil
+}
+
+// builds the FTS5 query for q's words: each word is a quoted prefix, and
+// all of them must appear in the searched columns
+func matchExpr(q search.Query) string {
+	var terms []string
+	for _, w := range strings.Fields(q.Text) {
+		terms = append(terms, `"`+strings.ReplaceAll(w, `"`, `""`)+`"*`)
+	}
+	if len(terms) == 0 {
+		return ""
+	}
+	expr := strings.Join(terms, " AND ")
+	if len(q.Fields) > 0 {
+		expr = "{" + strings.Join(q.Fields, " ") + "} : (" + expr + ")"
+	}
+	return expr
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// orders seasons as the CSVs are ordered
+func sortSeasons(seasons []string) {
+	sort.Slice(seasons, func(i, j int) bool {
+		return download.SeasonLess(seasons[i], seasons[j])
+	})
+}
@@ -0,0 +1,111 @@
+// Package config loads the optional j-archive.yaml file whose settings sit
+// between the built-in defaults and any flags given on the command line or
+// in JARCHIVE_* environment variables.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultFile is read from the working directory when -config isn't given
+const DefaultFile = "j-archive.yaml"
+
+// Config mirrors the command-line flags. Zero values mean "not set" and leave
+// the flag default in place.
+type Config struct {
+	Seasons     []string `yaml:"seasons"`
+	ArchiveDir  string   `yaml:"archive_dir"`
+	OutDir      string   `yaml:"out_dir"`
+	StatsDir    string   `yaml:"stats_dir"`
+	Index       string   `yaml:"index"`
+	Addr        string   `yaml:"addr"`
+	Concurrency int      `yaml:"concurrency"`
+	Delay       struct {
+		Min time.Duration `yaml:"min"`
+		Max time.Duration `yaml:"max"`
+	} `yaml:"delay"`
+	// J! Archive's address
+	BaseURL string `yaml:"base_url"`
+	NoHTTP2 *bool  `yaml:"no_http2"`
+	// requests per minute to J! Archive
+	Rate      float64 `yaml:"rate"`
+	Refresh   *bool   `yaml:"refresh"`
+	OlderThan string  `yaml:"older_than"`
+	// download showscores.php and showplayer.php pages too
+	Scores  *bool `yaml:"scores"`
+	Players *bool `yaml:"players"`
+	// how long cached season pages are used for
+	SeasonTTL       string `yaml:"season_ttl"`
+	RawText         *bool  `yaml:"raw_text"`
+	Markdown        *bool  `yaml:"markdown"`
+	Unrevealed      *bool  `yaml:"unrevealed"`
+	ValueNormalized *bool  `yaml:"value_normalized"`
+	// the -value-normalized-cutoff date and -value-normalized-factor
+	ValueNormalizedCutoff string `yaml:"value_normalized_cutoff"`
+	ValueNormalizedFactor *int   `yaml:"value_normalized_factor"`
+	ValueAdjusted         *bool  `yaml:"value_adjusted"`
+	Difficulty            *bool  `yaml:"difficulty"`
+	DateFields            *bool  `yaml:"date_fields"`
+	BOM                   *bool  `yaml:"bom"`
+	CRLF                  *bool  `yaml:"crlf"`
+	Newlines              string `yaml:"newlines"`
+	Incremental           *bool  `yaml:"incremental"`
+	Layout                string `yaml:"layout"`
+	NoProgress            *bool  `yaml:"no_progress"`
+	LogLevel              string `yaml:"log_level"`
+	LogFormat             string `yaml:"log_format"`
+	// where traces are sent
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+	NotifyURL    string `yaml:"notify_url"`
+	// where the run summary is written
+	Summary string `yaml:"summary"`
+	// time between the daemon's syncs
+	Interval time.Duration `yaml:"interval"`
+	// where the daemon serves /metrics
+	MetricsAddr string `yaml:"metrics_addr"`
+	// where parse, sync, stats and export upload what they write
+	S3 struct {
+		Bucket   string `yaml:"bucket"`
+		Endpoint string `yaml:"endpoint"`
+		Region   string `yaml:"region"`
+		Prefix   string `yaml:"prefix"`
+	} `yaml:"s3"`
+	GCS struct {
+		Bucket      string `yaml:"bucket"`
+		Prefix      string `yaml:"prefix"`
+		Credentials string `yaml:"credentials"`
+	} `yaml:"gcs"`
+}
+
+// reads the config at path, then the environment variables for settings
+// without a flag. An empty path means DefaultFile, which is allowed to be
+// missing; an explicitly named file must exist.
+func Load(path string) (*Config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = DefaultFile
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) && !explicit {
+		cfg := &Config{}
+		return cfg, cfg.applyEnv()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading config %s: %v", path, err)
+	}
+
+	var cfg Config
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error decoding config %s: %v", path, err)
+	}
+	return &cfg, cfg.applyEnv()
+}
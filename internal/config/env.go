@@ -0,0 +1,53 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvPrefix starts the name of every environment variable jarchive reads
+const EnvPrefix = "JARCHIVE_"
+
+// returns the environment variable for a flag, e.g. JARCHIVE_ARCHIVE_DIR
+// for -archive-dir
+func EnvName(flag string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(flag, "-", "_"))
+}
+
+// returns the value of an environment variable, treating an empty one as
+// unset so that a blank entry in a container spec doesn't override anything
+func LookupEnv(name string) (string, bool) {
+	v, ok := os.LookupEnv(name)
+	return v, ok && v != ""
+}
+
+// sets the settings that have no flag from their environment variables,
+// which win over the file
+func (c *Config) applyEnv() error {
+	var errs []error
+	if v, ok := LookupEnv(EnvPrefix + "CONCURRENCY"); ok {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			errs = append(errs, fmt.Errorf("invalid %sCONCURRENCY %q: want a positive number", EnvPrefix, v))
+		}
+		c.Concurrency = n
+	}
+	for _, delay := range []struct {
+		name string
+		d    *time.Duration
+	}{{"DELAY_MIN", &c.Delay.Min}, {"DELAY_MAX", &c.Delay.Max}} {
+		v, ok := LookupEnv(EnvPrefix + delay.name)
+		if !ok {
+			continue
+		}
+		var err error
+		if *delay.d, err = time.ParseDuration(v); err != nil {
+			errs = append(errs, fmt.Errorf("invalid %s%s %q: %v", EnvPrefix, delay.name, v, err))
+		}
+	}
+	return errors.Join(errs...)
+}
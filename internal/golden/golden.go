@@ -0,0 +1,82 @@
+// Package golden compares test output with golden files in testdata, which
+// go test -update rewrites.
+package golden
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// compares got with the golden file at path, or rewrites it with -update
+func Compare(t *testing.T, path string, got []byte) {
+	t.Helper()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s (run go test -update to accept):\n%s", path, FirstDiff(want, got))
+	}
+}
+
+// describes the first line where want and got differ
+func FirstDiff(want, got []byte) string {
+	wl := strings.Split(string(want), "\n")
+	gl := strings.Split(string(got), "\n")
+	for i := 0; i < len(wl) || i < len(gl); i++ {
+		var w, g string
+		if i < len(wl) {
+			w = wl[i]
+		}
+		if i < len(gl) {
+			g = gl[i]
+		}
+		if w != g {
+			return "line " + strconv.Itoa(i+1) + ":\n  want: " + w + "\n  got:  " + g
+		}
+	}
+	return "files differ"
+}
+
+// copies the parse package's golden CSVs into a temporary CSV directory,
+// one season each named after its fixture, for the tests of the packages
+// that read parse's output. The path is relative to a package directory
+// next to parse.
+func Seasons(t *testing.T) string {
+	t.Helper()
+	csvs, err := filepath.Glob(filepath.Join("..", "parse", "testdata", "*.golden.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(csvs) == 0 {
+		t.Fatal("no CSVs in ../parse/testdata")
+	}
+	csvDir := t.TempDir()
+	for _, path := range csvs {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		season := strings.TrimSuffix(filepath.Base(path), ".golden.csv")
+		if err := os.WriteFile(filepath.Join(csvDir, "j-archive-season-"+season+".csv"), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return csvDir
+}
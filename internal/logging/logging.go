@@ -0,0 +1,68 @@
+// Package logging configures the process-wide slog logger shared by the
+// download and parse packages.
+package logging
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+)
+
+// output is the writer every handler logs to; Redirect swaps it so a
+// progress display can clear its status line before each record
+var output = &switchWriter{w: os.Stderr}
+
+type switchWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *switchWriter) Write(b []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(b)
+}
+
+// installs the default slog logger. level is one of debug, info, warn or
+// error and format is text or json.
+func Setup(level, format string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("invalid log level %q", level)
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	switch strings.ToLower(format) {
+	case "text":
+		handler = slog.NewTextHandler(output, opts)
+	case "json":
+		handler = slog.NewJSONHandler(output, opts)
+	default:
+		return fmt.Errorf("invalid log format %q, expected text or json", format)
+	}
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+// sends log output to w until the returned function is called
+func Redirect(w io.Writer) (restore func()) {
+	output.mu.Lock()
+	prev := output.w
+	output.w = w
+	output.mu.Unlock()
+	return func() {
+		output.mu.Lock()
+		output.w = prev
+		output.mu.Unlock()
+	}
+}
+
+// logs at error level and exits, replacing log.Fatalf
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
@@ -0,0 +1,104 @@
+// Package statusline redraws a single status line in place on a terminal.
+package statusline
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const interval = 500 * time.Millisecond
+
+// Line periodically redraws the text returned by its render function. It also
+// implements io.Writer so it can be used as log output: the status line is
+// cleared before each message and redrawn on the next tick.
+type Line struct {
+	mu      sync.Mutex
+	out     io.Writer
+	render  func() string
+	enabled bool
+	drawn   bool
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// starts a status line on stderr. It is disabled, and never draws anything,
+// when enabled is false or stderr isn't a terminal.
+func New(enabled bool, render func() string) *Line {
+	l := &Line{
+		out:     os.Stderr,
+		render:  render,
+		enabled: enabled && isTerminal(os.Stderr),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	if !l.enabled {
+		close(l.stopped)
+		return l
+	}
+	go func() {
+		defer close(l.stopped)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				l.mu.Lock()
+				l.draw()
+				l.mu.Unlock()
+			case <-l.stop:
+				return
+			}
+		}
+	}()
+	return l
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// reports whether the line is being drawn
+func (l *Line) Enabled() bool {
+	return l.enabled
+}
+
+func (l *Line) Write(b []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.clear()
+	return l.out.Write(b)
+}
+
+// stops redrawing and leaves the final state on screen
+func (l *Line) Finish() {
+	if !l.enabled {
+		return
+	}
+	close(l.stop)
+	<-l.stopped
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.draw()
+	fmt.Fprintln(l.out)
+	l.drawn = false
+}
+
+// must be called with l.mu held
+func (l *Line) clear() {
+	if l.drawn {
+		fmt.Fprint(l.out, "\r\033[K")
+		l.drawn = false
+	}
+}
+
+// must be called with l.mu held
+func (l *Line) draw() {
+	text := l.render()
+	l.clear()
+	fmt.Fprint(l.out, text)
+	l.drawn = true
+}
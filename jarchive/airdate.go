@@ -0,0 +1,37 @@
+package jarchive
+
+import (
+	"log/slog"
+	"regexp"
+	"time"
+)
+
+var (
+	// a date in the page title; some older titles leave the month or day
+	// unpadded
+	titleDateRe = regexp.MustCompile(`(\d{4})-(\d{1,2})-(\d{1,2})`)
+	// the long date in the heading, e.g. "Monday, September 11, 2023"
+	headingDateRe = regexp.MustCompile(`[A-Z][a-z]+ \d{1,2}, \d{4}`)
+)
+
+// returns the air date as YYYY-MM-DD from the page title, padding an
+// unpadded month or day. A title without a real date, such as 2023-02-30
+// or none at all, falls back to the long date in the heading; empty when
+// neither has one.
+func parseAirDate(title, heading string) string {
+	if m := titleDateRe.FindStringSubmatch(title); m != nil {
+		if d, err := time.Parse("2006-1-2", m[1]+"-"+m[2]+"-"+m[3]); err == nil {
+			return d.Format(time.DateOnly)
+		}
+	}
+	if s := headingDateRe.FindString(heading); s != "" {
+		if d, err := time.Parse("January 2, 2006", s); err == nil {
+			slog.Debug("air date taken from the heading", "title", title, "heading", heading)
+			return d.Format(time.DateOnly)
+		}
+	}
+	if titleDateRe.MatchString(title) {
+		slog.Warn("ignoring invalid air date", "title", title)
+	}
+	return ""
+}
@@ -0,0 +1,22 @@
+package jarchive
+
+import "testing"
+
+func TestParseAirDate(t *testing.T) {
+	tests := []struct {
+		title, heading, want string
+	}{
+		{"J! Archive - Show #9000, aired 2023-09-11", "Show #9000 - Monday, September 11, 2023", "2023-09-11"},
+		// unpadded
+		{"J! Archive - Show #2481, aired 1995-5-2", "", "1995-05-02"},
+		// not a date, or missing: the heading's
+		{"J! Archive - Show #8123, aired 2019-02-30", "Show #8123 - Tuesday, October 1, 2019", "2019-10-01"},
+		{"J! Archive - Show #8123", "Show #8123 - October 1, 2019", "2019-10-01"},
+		{"J! Archive - Show #8123, aired 2019-13-01", "Show #8123", ""},
+	}
+	for _, tt := range tests {
+		if got := parseAirDate(tt.title, tt.heading); got != tt.want {
+			t.Errorf("parseAirDate(%q, %q) = %q, want %q", tt.title, tt.heading, got, tt.want)
+		}
+	}
+}
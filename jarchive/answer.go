@@ -0,0 +1,130 @@
+package jarchive
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// returns the form of a correct response that answer checking compares:
+// lowercased, with accents, quotes and other punctuation stripped, "&"
+// spelled out and a leading "the", "a" or "an" dropped, so "The Café
+// Procope" and "cafe procope" come out the same. It is what the
+// answer_normalized column holds.
+func NormalizeAnswer(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.ReplaceAll(s, "&", " and ")
+	var b strings.Builder
+	for _, r := range norm.NFD.String(s) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// accent marks, split off by NFD
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '\'' || r == '’':
+			// "O'Hare" and "OHare" are the same
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return trimArticle(strings.Join(strings.Fields(b.String()), " "))
+}
+
+// drops a leading article from a normalized answer
+func trimArticle(s string) string {
+	for _, article := range []string{"the ", "a ", "an "} {
+		s = strings.TrimPrefix(s, article)
+	}
+	return s
+}
+
+var (
+	// "what is", "who are" and so on in front of a response
+	questionRe = regexp.MustCompile(`^(what|who|where|when|which)\s*(is|are|was|were|s|re)\s+`)
+	// parenthesized parts of a correct response, e.g. "(Leonardo) da Vinci"
+	parenRe = regexp.MustCompile(`\(([^)]*)\)`)
+)
+
+// reports whether a given response should count as the correct response,
+// with the leniency of the show's judges: case, accents, punctuation, a
+// leading "what is" and leading articles are ignored, parenthesized parts
+// of correct are optional (or alternatives when they start with "or"), and
+// small misspellings are forgiven.
+func MatchesAnswer(given, correct string) bool {
+	r := normalizeResponse(given)
+	if r == "" {
+		return false
+	}
+	for _, want := range variants(correct) {
+		if r == want || nearly(r, want) {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the normalized forms a correct response can take: with and without each
+// optional part, and each "(or ...)" alternative on its own
+func variants(answer string) []string {
+	var alternatives []string
+	optional := parenRe.ReplaceAllStringFunc(answer, func(m string) string {
+		inner := strings.TrimSpace(m[1 : len(m)-1])
+		if alt, ok := strings.CutPrefix(inner, "or "); ok {
+			alternatives = append(alternatives, alt)
+			return ""
+		}
+		return m
+	})
+	without := parenRe.ReplaceAllString(optional, "")
+	with := strings.NewReplacer("(", "", ")", "").Replace(optional)
+
+	var out []string
+	seen := make(map[string]bool)
+	for _, v := range append([]string{without, with}, alternatives...) {
+		if v = normalizeResponse(v); v != "" && !seen[v] {
+			seen[v] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// normalizes s as NormalizeAnswer does, also dropping a leading "what is"
+func normalizeResponse(s string) string {
+	s = NormalizeAnswer(s)
+	if t := questionRe.ReplaceAllString(s, ""); t != s {
+		s = NormalizeAnswer(t)
+	}
+	return s
+}
+
+// reports whether got is within a typo or two of want: one edit for every
+// six letters, none for short words
+func nearly(got, want string) bool {
+	allowed := len([]rune(want)) / 6
+	return allowed > 0 && distance(got, want) <= allowed
+}
+
+// Levenshtein distance between a and b
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,48 @@
+package jarchive
+
+import "testing"
+
+func TestMatchesAnswer(t *testing.T) {
+	tests := []struct {
+		given, correct string
+		want           bool
+	}{
+		{"Casablanca", "Casablanca", true},
+		{"what is casablanca", "Casablanca", true},
+		{"Who's Tolkien?", "J.R.R. Tolkien", false},
+		{"who is j.r.r. tolkien", "J.R.R. Tolkien", true},
+		{"the beatles", "Beatles", true},
+		{"beatles", "the Beatles", true},
+		{"da vinci", "(Leonardo) da Vinci", true},
+		{"leonardo da vinci", "(Leonardo) da Vinci", true},
+		{"the fed", "Federal Reserve (or the Fed)", true},
+		{"cafe procope", "The Café Procope", true},
+		{"mississipi", "Mississippi", true},
+		{"cat", "bat", false},
+		{"", "Casablanca", false},
+		{"what is", "Casablanca", false},
+		{"o hare", "O'Hare", false},
+		{"ohare", "O'Hare", true},
+		{"salt and pepper", "salt & pepper", true},
+	}
+	for _, tt := range tests {
+		if got := MatchesAnswer(tt.given, tt.correct); got != tt.want {
+			t.Errorf("MatchesAnswer(%q, %q) = %v, want %v", tt.given, tt.correct, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeAnswer(t *testing.T) {
+	tests := map[string]string{
+		"The Café Procope":  "cafe procope",
+		"*Casablanca*":      "casablanca",
+		"a \"Tale\" & more": "tale and more",
+		"O'Hare":            "ohare",
+		"What's My Line?":   "whats my line",
+	}
+	for in, want := range tests {
+		if got := NormalizeAnswer(in); got != want {
+			t.Errorf("NormalizeAnswer(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package jarchive
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// parses each fixture from memory, so only the parser is measured. Compare
+// runs with benchstat:
+//
+//	go test ./jarchive -run '^$' -bench . -benchmem -count 10 > new.txt
+func BenchmarkParseGame(b *testing.B) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, fixture := range fixtures {
+		html, err := os.ReadFile(fixture)
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(strings.TrimSuffix(filepath.Base(fixture), ".html"), func(b *testing.B) {
+			b.SetBytes(int64(len(html)))
+			for b.Loop() {
+				if _, err := ParseGame(bytes.NewReader(html)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// parses the regular fixture's Double Jeopardy board from an already loaded
+// document, the part of ParseGame that runs the most selectors
+func BenchmarkParseRound(b *testing.B) {
+	html, err := os.ReadFile(filepath.Join("testdata", "regular.html"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(html))
+	if err != nil {
+		b.Fatal(err)
+	}
+	table := doc.Find("#double_jeopardy_round")
+	for _, opts := range []struct {
+		name string
+		opts Options
+	}{
+		{"default", Options{}},
+		{"markdown", Options{Markdown: true}},
+		{"unrevealed", Options{Unrevealed: true}},
+	} {
+		p := NewParser(opts.opts)
+		b.Run(opts.name, func(b *testing.B) {
+			for b.Loop() {
+				p.parseRound(RoundDoubleJeopardy, table, "9000")
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package jarchive
+
+import "math"
+
+// the first air date with today's board values, and how many times the
+// earlier ones they are worth
+const (
+	DoubledValuesDate   = "2001-11-26"
+	DoubledValuesFactor = 2
+)
+
+// how much of Difficulty comes from the clue's value; the rest comes from
+// whether anyone got it right
+const valueWeight = 0.6
+
+// the most a regular clue is worth today, at the bottom of the Double
+// Jeopardy board
+const topValue = 2000
+
+// grades a clue from 0 (easiest) to 1 (hardest) for a game that aired on
+// airDate, for quizzes that want to pick questions by difficulty. It
+// combines the clue's value on the post-doubling scale (values before
+// 2001-11-26 count double) as a share of the $2,000 at the bottom of the Double
+// Jeopardy board with whether it was a triple stumper. Daily Doubles,
+// whose value is a wager, are valued by their board row instead, and Final
+// Jeopardy and the tiebreaker count as the top value. false for unrevealed
+// clues, which nobody saw.
+func (c Clue) Difficulty(airDate string) (float64, bool) {
+	return c.ScaledDifficulty(airDate, DoubledValuesDate, DoubledValuesFactor)
+}
+
+// is Difficulty with the values of games that aired before cutoff
+// multiplied by factor, as ScaledValue does
+func (c Clue) ScaledDifficulty(airDate, cutoff string, factor int) (float64, bool) {
+	if !c.Revealed {
+		return 0, false
+	}
+	stumped := 0.0
+	if c.TripleStumper {
+		stumped = 1
+	}
+	d := valueWeight*min(float64(c.gradedValue(airDate, cutoff, factor))/topValue, 1) + (1-valueWeight)*stumped
+	return math.Round(d*100) / 100, true
+}
+
+// returns the clue's board value scaled to the post-doubling scale for a
+// game that aired on airDate: twice its value before the 2001-11-26
+// doubling, so clues of every era compare like with like. Daily Double wagers are doubled too,
+// as the scores they were made from were half today's. 0 for clues
+// without a value, and games without an air date count as today's.
+func (c Clue) NormalizedValue(airDate string) int {
+	return c.ScaledValue(airDate, DoubledValuesDate, DoubledValuesFactor)
+}
+
+// returns the clue's value multiplied by factor if its game aired on
+// airDate before cutoff (YYYY-MM-DD), for boards that changed on another
+// date or by another factor than NormalizedValue's. An empty cutoff or a
+// zero factor stand for DoubledValuesDate and DoubledValuesFactor.
+func (c Clue) ScaledValue(airDate, cutoff string, factor int) int {
+	if cutoff == "" {
+		cutoff = DoubledValuesDate
+	}
+	if factor == 0 {
+		factor = DoubledValuesFactor
+	}
+	if airDate != "" && airDate < cutoff {
+		return c.Value * factor
+	}
+	return c.Value
+}
+
+// returns what the clue would be worth on today's board, with values
+// before cutoff multiplied by factor, half the top value when that can't
+// be told
+func (c Clue) gradedValue(airDate, cutoff string, factor int) int {
+	if c.Round == RoundFinalJeopardy || c.Round == RoundTiebreaker {
+		return topValue
+	}
+	if !c.DailyDouble && c.Value > 0 {
+		return c.ScaledValue(airDate, cutoff, factor)
+	}
+	if c.Row == 0 {
+		return topValue / 2
+	}
+	step := 200
+	switch c.Round {
+	case RoundDoubleJeopardy:
+		step = 400
+	case RoundTripleJeopardy:
+		step = 600
+	}
+	return c.Row * step
+}
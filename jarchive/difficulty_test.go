@@ -0,0 +1,29 @@
+package jarchive
+
+import "testing"
+
+func TestScaledDifficulty(t *testing.T) {
+	clue := Clue{Round: RoundJeopardy, Value: 500, Revealed: true}
+	tests := []struct {
+		airDate, cutoff string
+		factor          int
+		want            float64
+	}{
+		// before the doubling: valued at $1,000
+		{"1995-05-12", DoubledValuesDate, DoubledValuesFactor, 0.3},
+		{"2024-01-02", DoubledValuesDate, DoubledValuesFactor, 0.15},
+		{"1995-05-12", "1990-01-01", DoubledValuesFactor, 0.15},
+		{"1995-05-12", DoubledValuesDate, 4, 0.6},
+		// empty and zero stand for the defaults
+		{"1995-05-12", "", 0, 0.3},
+	}
+	for _, tt := range tests {
+		got, ok := clue.ScaledDifficulty(tt.airDate, tt.cutoff, tt.factor)
+		if !ok || got != tt.want {
+			t.Errorf("ScaledDifficulty(%q, %q, %d) = %v, %t, want %v, true", tt.airDate, tt.cutoff, tt.factor, got, ok, tt.want)
+		}
+	}
+	if got, _ := clue.Difficulty("1995-05-12"); got != 0.3 {
+		t.Errorf("Difficulty = %v, want 0.3", got)
+	}
+}
@@ -0,0 +1,39 @@
+package jarchive
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrNoRounds means the page has none of the round sections, which is what
+// J! Archive error and placeholder pages look like
+var ErrNoRounds = errors.New("no rounds found")
+
+// ParseError is returned by ParseGame and ParseFile when a page can't be
+// parsed. Use errors.As to get at the details and errors.Is to check for
+// ErrNoRounds.
+type ParseError struct {
+	// file the page was read from; set by ParseFile, empty for ParseGame
+	File string
+	// round being parsed when the error happened, empty for page-level errors
+	Round string
+	// underlying cause
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	b.WriteString("parse")
+	if e.File != "" {
+		b.WriteString(" " + e.File)
+	}
+	if e.Round != "" {
+		b.WriteString(" (" + e.Round + " round)")
+	}
+	b.WriteString(": " + e.Err.Error())
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,39 @@
+package jarchive
+
+import "regexp"
+
+// values for Game.Format
+const (
+	// three players on the standard board
+	FormatRegular = "regular"
+	// celebrities playing for charity, sometimes with a Triple Jeopardy
+	// round or a smaller board
+	FormatCelebrity = "celebrity"
+	// teams of players, as in the All-Star Games
+	FormatTeam = "team"
+)
+
+// celebrity games the tournament names don't cover, e.g. "Power Players
+// Week game 2."
+var celebrityRe = regexp.MustCompile(`(?i)\b(celebrit(y|ies)|power players)\b`)
+
+// works out a game's format from its contestants, rounds and comments
+func gameFormat(g *Game) string {
+	for _, c := range g.Contestants {
+		if len(c.Members) > 0 {
+			return FormatTeam
+		}
+	}
+	if t := g.Tournament; t != nil && t.Name == "Celebrity Jeopardy!" {
+		return FormatCelebrity
+	}
+	for _, r := range g.Rounds {
+		if r.Name == RoundTripleJeopardy {
+			return FormatCelebrity
+		}
+	}
+	if celebrityRe.MatchString(firstSentence(g.Comments)) {
+		return FormatCelebrity
+	}
+	return FormatRegular
+}
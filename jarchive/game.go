@@ -0,0 +1,118 @@
+// Package jarchive parses J! Archive game pages (showgame.php) into typed
+// structs. It is the library behind the jarchive command and can be imported
+// by other Go programs that want the data without going through CSV.
+package jarchive
+
+// names used for Round.Name and Clue.Round
+const (
+	RoundJeopardy       = "Jeopardy"
+	RoundDoubleJeopardy = "Double Jeopardy"
+	// the third board of the primetime Celebrity Jeopardy! games
+	RoundTripleJeopardy = "Triple Jeopardy"
+	RoundFinalJeopardy  = "Final Jeopardy"
+	RoundTiebreaker     = "Tiebreaker"
+)
+
+// Game is one episode of the show
+type Game struct {
+	// J! Archive's game_id, from the page's links to its own scores or
+	// responses; empty if the page has neither
+	GameID string
+	// show number from the page title, e.g. "9000"
+	EpisodeNumber string
+	// air date as YYYY-MM-DD
+	AirDate string
+	// the comments under the game title, e.g. "Tournament of Champions
+	// final game 1."
+	Comments string
+	// the tournament or special event the comments place the game in; nil
+	// for regular games
+	Tournament *Tournament
+	// who hosted: named in the comments, or known from the air date; empty
+	// when neither says
+	Host string
+	// FormatRegular, FormatCelebrity or FormatTeam
+	Format string
+	// the players, or for team games the teams with their players as
+	// Members
+	Contestants []Contestant
+	// nickname of the contestant who got the tiebreaker clue right, as in
+	// Contestant.Nickname; empty for games without a tiebreaker
+	TiebreakerWinner string
+	// rounds in the order they were played; games without a tiebreaker have
+	// three, celebrity games with a Triple Jeopardy round four and some very
+	// old or incomplete games fewer
+	Rounds []Round
+}
+
+// Round is one of Jeopardy, Double Jeopardy, Triple Jeopardy, Final Jeopardy
+// or Tiebreaker
+type Round struct {
+	Name       string
+	Categories []string
+	// revealed clues in board order, left to right then top to bottom; with
+	// Options.Unrevealed the unrevealed ones are included in their place
+	Clues []Clue
+}
+
+// Clue is a single clue and its correct response
+type Clue struct {
+	// name of the round the clue belongs to
+	Round    string
+	Category string
+	// dollar value: the board value, or the wager for a Daily Double. 0 when
+	// unknown, as for Final Jeopardy and the tiebreaker. Unrevealed clues
+	// take the value of their board row.
+	Value int
+	// value text as found on the page, e.g. "$1,000" or "DD: $2,400"; for
+	// Final Jeopardy the comma-separated contestant wagers, if any
+	ValueRaw    string
+	DailyDouble bool
+	// empty for unrevealed clues
+	Question string
+	// asides that were in the clue cell, such as the host's "Alex: Last
+	// name only." or a Clue Crew stage direction, without their
+	// parentheses; empty for most clues
+	Notes  string
+	Answer string
+	// false for a clue left on the board when time ran out
+	Revealed bool
+	// nobody gave the correct response; always false for Daily Doubles,
+	// which only one contestant plays
+	TripleStumper bool
+	// position on the board: Column 1-6 is the category, left to right, and
+	// Row 1-5 the value, top to bottom, on a standard board (nonstandard
+	// ones may have fewer or more). Both are 0 for Final Jeopardy and the
+	// tiebreaker.
+	Column, Row int
+}
+
+// Contestant is a player as listed at the top of the game page, or in
+// team games a team
+type Contestant struct {
+	Name string
+	// id from the showplayer.php link, empty if the page has none and for
+	// teams
+	PlayerID string
+	// the rest of the contestant line, e.g. "a teacher from Springfield,
+	// Illinois"; for a team's players what follows their name, e.g.
+	// "captain"
+	Description string
+	// a team's players, nil for everyone else
+	Members []Contestant
+	// what the scores call the contestant, e.g. "Alice"; empty for a team's
+	// players and when the page has no final scores
+	Nickname string
+	// score at the end of the game, from the page's final scores; nil for
+	// a team's players and when the page has none
+	FinalScore *int
+}
+
+// returns every clue in the game, round by round
+func (g *Game) Clues() []Clue {
+	var clues []Clue
+	for _, r := range g.Rounds {
+		clues = append(clues, r.Clues...)
+	}
+	return clues
+}
@@ -0,0 +1,116 @@
+package jarchive
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// parses every testdata/*.html fixture and compares the resulting Game, as
+// indented JSON, with testdata/<name>.golden.json. Run with -update after an
+// intended parser change and review the diff.
+func TestGolden(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in testdata")
+	}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			checkGolden(t, defaultParser, fixture, filepath.Join("testdata", name+".golden.json"))
+		})
+	}
+}
+
+// parses fixtures with non-default options, each compared with
+// testdata/<fixture>.<variant>.golden.json
+func TestGoldenOptions(t *testing.T) {
+	variants := []struct {
+		fixture, name string
+		opts          Options
+	}{
+		{"regular", "markdown", Options{Markdown: true}},
+		{"regular", "unrevealed", Options{Unrevealed: true}},
+		{"old-era", "line-breaks", Options{LineBreaks: true}},
+	}
+	for _, v := range variants {
+		t.Run(v.name, func(t *testing.T) {
+			fixture := filepath.Join("testdata", v.fixture+".html")
+			checkGolden(t, NewParser(v.opts), fixture, filepath.Join("testdata", v.fixture+"."+v.name+".golden.json"))
+		})
+	}
+}
+
+// parses every testdata/scores/*.html fixture and compares the Scores, as
+// indented JSON, with testdata/scores/<name>.golden.json
+func TestGoldenScores(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "scores", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in testdata/scores")
+	}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			scores, err := ParseScoresFile(fixture)
+			if err != nil {
+				t.Fatalf("ParseScoresFile: %v", err)
+			}
+			got, err := json.MarshalIndent(scores, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "scores", name+".golden.json"), append(got, '\n'))
+		})
+	}
+}
+
+// parses every testdata/players/*.html fixture and compares the Player, as
+// indented JSON, with testdata/players/<name>.golden.json
+func TestGoldenPlayers(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("testdata", "players", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in testdata/players")
+	}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			player, err := ParsePlayerFile(fixture)
+			if err != nil {
+				t.Fatalf("ParsePlayerFile: %v", err)
+			}
+			got, err := json.MarshalIndent(player, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "players", name+".golden.json"), append(got, '\n'))
+		})
+	}
+}
+
+// parses fixture with p and compares the Game, as indented JSON, with the
+// golden file at path
+func checkGolden(t *testing.T, p *Parser, fixture, path string) {
+	t.Helper()
+	game, err := p.ParseFile(fixture)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+	got, err := json.MarshalIndent(game, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = append(got, '\n')
+	golden.Compare(t, path, got)
+}
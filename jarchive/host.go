@@ -0,0 +1,70 @@
+package jarchive
+
+import (
+	"regexp"
+	"strings"
+)
+
+// who hosted by air date: each entry covers the air dates from its start up
+// to the next entry's. An empty host marks a stretch where more than one
+// person hosted and the air date alone doesn't say who.
+var hostEras = []struct {
+	start string
+	host  string
+}{
+	{"1984-09-10", "Alex Trebek"},
+	// the guest hosts of season 37, after Trebek's last episode
+	{"2021-01-11", "Ken Jennings"},
+	{"2021-02-22", "Mike Richards"},
+	{"2021-03-08", "Katie Couric"},
+	{"2021-03-22", "Mehmet Oz"},
+	{"2021-04-05", "Aaron Rodgers"},
+	{"2021-04-19", "Anderson Cooper"},
+	{"2021-05-03", "Bill Whitaker"},
+	{"2021-05-17", "Buzzy Cohen"},
+	{"2021-05-31", "Mayim Bialik"},
+	{"2021-06-14", "Sanjay Gupta"},
+	// the rest of the guest hosts, then Jennings and Bialik sharing the job
+	{"2021-06-28", ""},
+	{"2023-09-11", "Ken Jennings"},
+}
+
+// single games hosted by someone other than the era's host
+var hostExceptions = map[string]string{
+	// April Fools' Day swap with Wheel of Fortune
+	"1997-04-01": "Pat Sajak",
+}
+
+var (
+	// "Ken Jennings guest hosts.", "Mayim Bialik hosted."
+	hostsRe = regexp.MustCompile(`\b([A-Z][\w.'-]*(?:\s+[A-Z][\w.'-]*)+)\s+(?:guest[- ])?host(?:s|ed)\b`)
+	// "hosted by Ken Jennings", "Guest host: LeVar Burton"
+	hostedByRe = regexp.MustCompile(`(?i:hosted by|guest host:?)\s+([A-Z][\w.'-]*(?:\s+[A-Z][\w.'-]*)+)`)
+)
+
+// returns who hosted a game: named in its comments if they say, otherwise
+// worked out from the air date; empty if neither tells
+func parseHost(comments, airDate string) string {
+	for _, re := range []*regexp.Regexp{hostedByRe, hostsRe} {
+		if m := re.FindStringSubmatch(comments); m != nil {
+			// the end of the sentence, not an initial
+			return strings.TrimSuffix(strings.Join(strings.Fields(m[1]), " "), ".")
+		}
+	}
+	return hostOn(airDate)
+}
+
+// returns who hosted the show on an air date, empty when that isn't known
+func hostOn(airDate string) string {
+	if host, ok := hostExceptions[airDate]; ok {
+		return host
+	}
+	host := ""
+	for _, era := range hostEras {
+		// YYYY-MM-DD sorts as a string
+		if airDate >= era.start {
+			host = era.host
+		}
+	}
+	return host
+}
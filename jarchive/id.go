@@ -0,0 +1,67 @@
+package jarchive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// Stable IDs are 16 hex digits of the SHA-256 of what identifies the game,
+// round or clue, so they are the same in every output format and every
+// release, whatever else about it changes. A game is identified by its J!
+// Archive game_id, or when that isn't known by its season and show number.
+
+// returns a game's stable ID
+func StableGameID(gameID, season, episode string) string {
+	return stableID(gameName(gameID, season, episode))
+}
+
+// returns the stable ID of one round of a game
+func RoundID(gameID, season, episode, round string) string {
+	return stableID(gameName(gameID, season, episode), round)
+}
+
+// returns a clue's stable ID, from its game, round and board position
+func ClueID(gameID, season, episode string, c Clue) string {
+	return stableID(gameName(gameID, season, episode), c.Round, strconv.Itoa(c.Column), strconv.Itoa(c.Row))
+}
+
+func gameName(gameID, season, episode string) string {
+	if gameID == "" {
+		return season + "/" + episode
+	}
+	return gameID
+}
+
+func stableID(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// IdentifiedRound is a round as the JSON outputs write it, each clue with
+// its stable ID
+type IdentifiedRound struct {
+	Name       string
+	Categories []string
+	Clues      []IdentifiedClue
+}
+
+// IdentifiedClue is a clue with its stable ID, the clue_id column
+type IdentifiedClue struct {
+	ID string
+	Clue
+}
+
+// returns the game's rounds with the stable ID of every clue, for a game
+// of season
+func (g *Game) IdentifiedRounds(season string) []IdentifiedRound {
+	rounds := make([]IdentifiedRound, len(g.Rounds))
+	for i, r := range g.Rounds {
+		rounds[i] = IdentifiedRound{Name: r.Name, Categories: r.Categories, Clues: make([]IdentifiedClue, len(r.Clues))}
+		for j, c := range r.Clues {
+			rounds[i].Clues[j] = IdentifiedClue{ClueID(g.GameID, season, g.EpisodeNumber, c), c}
+		}
+	}
+	return rounds
+}
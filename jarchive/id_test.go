@@ -0,0 +1,57 @@
+package jarchive
+
+import "testing"
+
+func TestClueID(t *testing.T) {
+	clue := Clue{Round: RoundDoubleJeopardy, Category: "ART", Value: 800, Question: "This painter", Column: 1, Row: 2}
+	id := ClueID("7777", "40", "9000", clue)
+	if len(id) != 16 {
+		t.Fatalf("ClueID = %q, want 16 hex digits", id)
+	}
+	// only the game, round and board position count
+	edited := clue
+	edited.Category, edited.Value, edited.Question = "FINE ART", 1600, "This French painter"
+	if got := ClueID("7777", "41", "9001", edited); got != id {
+		t.Errorf("ClueID of the edited clue = %q, want %q", got, id)
+	}
+	for _, other := range []string{
+		ClueID("7778", "40", "9000", clue),
+		ClueID("7777", "40", "9000", Clue{Round: RoundJeopardy, Column: 1, Row: 2}),
+		ClueID("7777", "40", "9000", Clue{Round: RoundDoubleJeopardy, Column: 2, Row: 1}),
+		ClueID("", "40", "9000", clue),
+	} {
+		if other == id {
+			t.Errorf("ClueID of another clue is %q too", id)
+		}
+	}
+	if ClueID("", "40", "9000", clue) != ClueID("", "40", "9000", edited) {
+		t.Error("ClueID without a game_id differs between edits of the same clue")
+	}
+}
+
+func TestGameAndRoundIDs(t *testing.T) {
+	game := StableGameID("7777", "40", "9000")
+	if game == StableGameID("7778", "40", "9000") || game == StableGameID("", "40", "9000") {
+		t.Errorf("StableGameID of another game is %q too", game)
+	}
+	if got := StableGameID("7777", "41", "9001"); got != game {
+		t.Errorf("StableGameID with a new show number = %q, want %q", got, game)
+	}
+	jeopardy, double := RoundID("7777", "40", "9000", RoundJeopardy), RoundID("7777", "40", "9000", RoundDoubleJeopardy)
+	if jeopardy == double || jeopardy == game || jeopardy == RoundID("7778", "40", "9000", RoundJeopardy) {
+		t.Errorf("RoundID %q isn't unique", jeopardy)
+	}
+}
+
+func TestIdentifiedRounds(t *testing.T) {
+	clue := Clue{Round: RoundJeopardy, Category: "ART", Value: 200, Column: 1, Row: 1}
+	game := &Game{GameID: "7777", EpisodeNumber: "9000", Rounds: []Round{{Name: RoundJeopardy, Categories: []string{"ART"}, Clues: []Clue{clue}}}}
+	rounds := game.IdentifiedRounds("40")
+	if len(rounds) != 1 || len(rounds[0].Clues) != 1 {
+		t.Fatalf("got %d rounds, want 1 with 1 clue", len(rounds))
+	}
+	got := rounds[0].Clues[0]
+	if want := ClueID("7777", "40", "9000", clue); got.ID != want || got.Clue != clue {
+		t.Errorf("got %q for %+v, want %q for %+v", got.ID, got.Clue, want, clue)
+	}
+}
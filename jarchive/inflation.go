@@ -0,0 +1,72 @@
+package jarchive
+
+import (
+	_ "embed"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// the annual average US consumer price index (CPI-U, 1982-84 = 100) from
+// the Bureau of Labor Statistics, one "year,cpi" line per year
+//
+//go:embed cpi.csv
+var cpiTable string
+
+// the CPI of each year the table covers, from cpiFirstYear to CPIYear
+var cpi = func() map[int]float64 {
+	values := make(map[int]float64)
+	for _, line := range strings.Split(strings.TrimSpace(cpiTable), "\n")[1:] {
+		year, value, _ := strings.Cut(line, ",")
+		y, err := strconv.Atoi(year)
+		if err != nil {
+			panic("bad cpi.csv line " + line)
+		}
+		if values[y], err = strconv.ParseFloat(value, 64); err != nil {
+			panic("bad cpi.csv line " + line)
+		}
+	}
+	return values
+}()
+
+// CPIYear is the last year of the bundled CPI table, whose dollars
+// AdjustForInflation converts to
+var CPIYear, cpiFirstYear = func() (last, first int) {
+	for y := range cpi {
+		if last == 0 || y > last {
+			last = y
+		}
+		if first == 0 || y < first {
+			first = y
+		}
+	}
+	return last, first
+}()
+
+// converts dollars won or wagered in a game that aired on airDate
+// (YYYY-MM-DD) into dollars of CPIYear, the last year of the bundled CPI
+// table, by the ratio of the two years' annual CPI. Games aired after
+// CPIYear keep their dollars. false when the air date is missing or before
+// the table starts.
+func AdjustForInflation(dollars int, airDate string) (int, bool) {
+	if len(airDate) < 4 {
+		return 0, false
+	}
+	year, err := strconv.Atoi(airDate[:4])
+	if err != nil || year < cpiFirstYear {
+		return 0, false
+	}
+	if year >= CPIYear {
+		return dollars, true
+	}
+	return int(math.Round(float64(dollars) * cpi[CPIYear] / cpi[year])), true
+}
+
+// returns the clue's value (a Daily Double's wager) in CPIYear dollars,
+// see AdjustForInflation; false for clues without a value too
+func (c Clue) AdjustedValue(airDate string) (int, bool) {
+	if c.Value == 0 {
+		return 0, false
+	}
+	return AdjustForInflation(c.Value, airDate)
+}
@@ -0,0 +1,28 @@
+package jarchive
+
+import "testing"
+
+func TestAdjustForInflation(t *testing.T) {
+	tests := []struct {
+		dollars int
+		airDate string
+		want    int
+		ok      bool
+	}{
+		{1000, "1995-05-12", 2058, true},
+		{1000, "2024-01-02", 1000, true},
+		// later than the table: kept as is
+		{1000, "2031-06-01", 1000, true},
+		{1000, "", 0, false},
+		{1000, "1950-01-01", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := AdjustForInflation(tt.dollars, tt.airDate)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("AdjustForInflation(%d, %q) = %d, %t, want %d, %t", tt.dollars, tt.airDate, got, ok, tt.want, tt.ok)
+		}
+	}
+	if CPIYear != 2024 {
+		t.Errorf("CPIYear = %d, want 2024", CPIYear)
+	}
+}
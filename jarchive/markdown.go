@@ -0,0 +1,124 @@
+package jarchive
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+// escapes characters that would otherwise read as Markdown formatting
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`)
+
+// stands in for a <br> while text is extracted. Normalizing treats it as
+// whitespace unless the Parser keeps line breaks; raw text gets a "\n".
+const lineBreak = "\u2028"
+
+// returns the text of a clue or response cell, as Markdown when the Parser
+// is set up for it and as plain text otherwise
+func (p *Parser) text(sel *goquery.Selection) string {
+	if !p.opts.Markdown {
+		return p.plainText(sel)
+	}
+	var b strings.Builder
+	for _, n := range sel.Nodes {
+		writeMarkdownChildren(&b, n)
+	}
+	return p.lineBreaks(strings.TrimSpace(b.String()))
+}
+
+// returns the text of the selection like goquery's Text, with a line break
+// for every <br>
+func (p *Parser) plainText(sel *goquery.Selection) string {
+	var b strings.Builder
+	for _, n := range sel.Nodes {
+		writePlain(&b, n)
+	}
+	return p.lineBreaks(strings.TrimSpace(b.String()))
+}
+
+func writePlain(b *strings.Builder, n *html.Node) {
+	switch {
+	case n.Type == html.TextNode:
+		b.WriteString(n.Data)
+	case n.Type == html.ElementNode && n.Data == "br":
+		b.WriteString(lineBreak)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writePlain(b, c)
+	}
+}
+
+// turns the <br> placeholders of raw text into newlines; normalizing takes
+// care of them otherwise
+func (p *Parser) lineBreaks(s string) string {
+	if !p.opts.RawText {
+		return s
+	}
+	return strings.ReplaceAll(s, lineBreak, "\n")
+}
+
+func writeMarkdownChildren(b *strings.Builder, n *html.Node) {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		writeMarkdown(b, c)
+	}
+}
+
+// writes n as Markdown: <i>/<em> become *emphasis*, <b>/<strong> become
+// **strong** and links become [text](href). Other elements contribute just
+// their text.
+func writeMarkdown(b *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		b.WriteString(markdownEscaper.Replace(n.Data))
+		return
+	case html.ElementNode:
+	default:
+		writeMarkdownChildren(b, n)
+		return
+	}
+	if n.Data == "br" {
+		b.WriteString(lineBreak)
+		return
+	}
+
+	var before, after string
+	switch n.Data {
+	case "i", "em":
+		before, after = "*", "*"
+	case "b", "strong":
+		before, after = "**", "**"
+	case "a":
+		href := attr(n, "href")
+		if href == "" {
+			writeMarkdownChildren(b, n)
+			return
+		}
+		before, after = "[", "]("+href+")"
+	default:
+		writeMarkdownChildren(b, n)
+		return
+	}
+
+	var inner strings.Builder
+	writeMarkdownChildren(&inner, n)
+	text := inner.String()
+	trimmed := strings.TrimSpace(text)
+	if trimmed == "" {
+		b.WriteString(text)
+		return
+	}
+	// keep surrounding spaces outside the markers, "* x *" isn't emphasis
+	lead := text[:strings.Index(text, trimmed)]
+	trail := text[len(lead)+len(trimmed):]
+	b.WriteString(lead + before + trimmed + after + trail)
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
@@ -0,0 +1,87 @@
+package jarchive
+
+import (
+	"html"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// maps the typographic characters J! Archive pages use inconsistently to
+// plain ones, so the same answer is spelled the same way in every game
+var textReplacer = strings.NewReplacer(
+	// quotes escaped for the onmouseover JavaScript strings
+	`\'`, "'",
+	`\"`, `"`,
+	"\u2018", "'", // left single quote
+	"\u2019", "'", // right single quote / apostrophe
+	"\u201a", "'", // single low quote
+	"\u201c", `"`, // left double quote
+	"\u201d", `"`, // right double quote
+	"\u201e", `"`, // double low quote
+	"\u00a0", " ", // no-break space
+	"\u2007", " ", // figure space
+	"\u2009", " ", // thin space
+	"\u202f", " ", // narrow no-break space
+	"\u200b", "", // zero width space
+	"\ufeff", "", // byte order mark
+	"\u00ad", "", // soft hyphen
+)
+
+// cleans up a text field: decodes entities left over from double-escaped
+// markup, straightens quotes, turns odd spaces into plain ones, collapses
+// runs of whitespace and returns valid NFC-normalized UTF-8
+func normalizeText(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	if strings.Contains(s, "&") {
+		s = html.UnescapeString(s)
+	}
+	s = textReplacer.Replace(s)
+	s = strings.Join(strings.Fields(s), " ")
+	return norm.NFC.String(s)
+}
+
+// like normalizeText, but when lineBreaks is set each line between <br>
+// tags is normalized on its own and they are joined with "\n", leaving out
+// empty ones
+func normalizeLines(s string, lineBreaks bool) string {
+	if !lineBreaks {
+		return normalizeText(s)
+	}
+	var lines []string
+	for _, line := range strings.Split(s, lineBreak) {
+		if line = normalizeText(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// normalizes every text field of the game in place, keeping the line breaks
+// of categories, clues and responses when lineBreaks is set
+func normalizeGame(g *Game, lineBreaks bool) {
+	g.Comments = normalizeText(g.Comments)
+	normalizeContestants(g.Contestants)
+	for i := range g.Rounds {
+		r := &g.Rounds[i]
+		for j := range r.Categories {
+			r.Categories[j] = normalizeLines(r.Categories[j], lineBreaks)
+		}
+		for j := range r.Clues {
+			c := &r.Clues[j]
+			c.Category = normalizeLines(c.Category, lineBreaks)
+			c.Question = normalizeLines(c.Question, lineBreaks)
+			c.Answer = normalizeLines(c.Answer, lineBreaks)
+		}
+	}
+}
+
+// normalizes the contestants, and the players of teams, in place
+func normalizeContestants(contestants []Contestant) {
+	for i := range contestants {
+		c := &contestants[i]
+		c.Name = normalizeText(c.Name)
+		c.Description = normalizeText(c.Description)
+		normalizeContestants(c.Members)
+	}
+}
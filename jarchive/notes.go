@@ -0,0 +1,36 @@
+package jarchive
+
+import (
+	"regexp"
+	"strings"
+)
+
+// a parenthetical aside that isn't part of the clue proper: the host's
+// remarks ("(Alex: Last name only.)"), the Clue Crew's stage directions
+// ("(Sarah of the Clue Crew reports from the Louvre.)") and celebrity
+// presenters introducing themselves ("(Hi, I'm Bob Barker.)")
+var noteRe = regexp.MustCompile(`\s*\(((?:[A-Z][\w.'-]*(?: [A-Z][\w.'-]*)?: |Hi, I'm )[^()]*|[^()]*\bClue Crew\b[^()]*)\)\s*`)
+
+// moves the asides out of the clue text, returning the clue without them
+// and the asides, without their parentheses, separated by spaces
+func splitNotes(text string) (clue, notes string) {
+	var found []string
+	clue = noteRe.ReplaceAllStringFunc(text, func(m string) string {
+		found = append(found, strings.TrimSpace(noteRe.FindStringSubmatch(m)[1]))
+		return " "
+	})
+	if found == nil {
+		return text, ""
+	}
+	return strings.TrimSpace(clue), strings.Join(found, " ")
+}
+
+// splits the asides off every clue of the game
+func splitGameNotes(g *Game) {
+	for i := range g.Rounds {
+		for j := range g.Rounds[i].Clues {
+			c := &g.Rounds[i].Clues[j]
+			c.Question, c.Notes = splitNotes(c.Question)
+		}
+	}
+}
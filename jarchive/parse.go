@@ -0,0 +1,455 @@
+package jarchive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+)
+
+var (
+	epNumRe    = regexp.MustCompile(`#(\d+)`)
+	playerIDRe = regexp.MustCompile(`player_id=(\d+)`)
+	gameIDRe   = regexp.MustCompile(`game_id=(\d+)`)
+	// a board clue's ID: its round, column and row
+	cluePositionRe = regexp.MustCompile(`^clue_[A-Z]+_(\d+)_(\d+)$`)
+	// commas, "and" and "&" between a team's players
+	teamSeparatorRe = regexp.MustCompile(`^[\s,;&]*(?:and\b)?[\s,;&]*|[\s,;&]*(?:\band)?[\s,;&]*$`)
+)
+
+// Options controls how a Parser turns pages into Games. The zero value is
+// what ParseFile and ParseGame use.
+type Options struct {
+	// keep text exactly as goquery extracts it instead of normalizing
+	// entities, quotes and whitespace
+	RawText bool
+	// write emphasis, bold and links in clues and responses as Markdown
+	// (*Title*, **word**, [text](url)) instead of dropping the markup
+	Markdown bool
+	// also return the clues nobody picked, as Clues with Revealed false, so
+	// that every Jeopardy and Double Jeopardy board is complete
+	Unrevealed bool
+	// keep the line breaks of <br> tags in categories, clues and responses
+	// as "\n" when normalizing, instead of turning them into spaces
+	LineBreaks bool
+}
+
+// Parser parses game pages with a fixed set of Options
+type Parser struct {
+	opts Options
+}
+
+// returns a Parser using opts
+func NewParser(opts Options) *Parser {
+	return &Parser{opts: opts}
+}
+
+var defaultParser = NewParser(Options{})
+
+// returns the options the Parser was created with
+func (p *Parser) Options() Options {
+	return p.opts
+}
+
+// parses a saved game page from disk. Errors from parsing are a *ParseError
+// with File set to path.
+func ParseFile(path string) (*Game, error) {
+	return defaultParser.ParseFile(path)
+}
+
+// parses a showgame.php page from any reader: a file, an HTTP response body
+// or an embedded fixture. Errors are always a *ParseError.
+func ParseGame(r io.Reader) (*Game, error) {
+	return defaultParser.ParseGame(r)
+}
+
+// like the package-level ParseFile, using the Parser's options
+func (p *Parser) ParseFile(path string) (*Game, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	game, err := p.ParseGame(f)
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.File = path
+	}
+	return game, err
+}
+
+// like the package-level ParseGame, using the Parser's options
+func (p *Parser) ParseGame(r io.Reader) (*Game, error) {
+	game, err := p.parseGame(r)
+	if err != nil {
+		return nil, err
+	}
+	if !p.opts.RawText {
+		normalizeGame(game, p.opts.LineBreaks)
+	}
+	splitGameNotes(game)
+	comments := normalizeText(game.Comments)
+	game.Tournament = parseTournament(comments)
+	game.Host = parseHost(comments, game.AirDate)
+	game.Format = gameFormat(game)
+	return game, nil
+}
+
+func (p *Parser) parseGame(r io.Reader) (*Game, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, &ParseError{Err: fmt.Errorf("invalid HTML: %w", err)}
+	}
+
+	// Extract episode number from the <title>.
+	titleText := doc.Find("title").Text()
+	game := &Game{}
+	if m := epNumRe.FindStringSubmatch(titleText); len(m) >= 2 {
+		game.EpisodeNumber = m[1]
+	}
+
+	game.AirDate = parseAirDate(titleText, doc.Find("#game_title").Text())
+	game.Comments = strings.TrimSpace(doc.Find("#game_comments").Text())
+	// links to other games (previous, next) carry their ids, not this one's
+	self := doc.Find(`a[href*="showscores.php?game_id="], a[href*="showgameresponses.php?game_id="]`).First()
+	if m := gameIDRe.FindStringSubmatch(self.AttrOr("href", "")); len(m) == 2 {
+		game.GameID = m[1]
+	}
+	game.Contestants = parseContestants(doc)
+	assignFinalScores(game.Contestants, finalScoresTable(doc))
+
+	hasRoundJ := doc.Find("#jeopardy_round").Length() > 0
+	hasRoundDJ := doc.Find("#double_jeopardy_round").Length() > 0
+	hasRoundTJ := doc.Find("#triple_jeopardy_round").Length() > 0
+	hasRoundFJ := doc.Find("#final_jeopardy_round").Length() > 0
+	hasRoundTB := doc.Find("#final_jeopardy_round .final_round").Length() > 1
+	slog.Debug("found rounds", "epNum", game.EpisodeNumber, "airDate", game.AirDate,
+		"jeopardy", hasRoundJ, "doubleJeopardy", hasRoundDJ, "tripleJeopardy", hasRoundTJ,
+		"finalJeopardy", hasRoundFJ, "tiebreaker", hasRoundTB)
+
+	if hasRoundJ {
+		jTable := doc.Find("#jeopardy_round")
+		game.Rounds = append(game.Rounds, p.parseRound(RoundJeopardy, jTable, game.EpisodeNumber))
+	}
+	if hasRoundDJ {
+		djTable := doc.Find("#double_jeopardy_round")
+		game.Rounds = append(game.Rounds, p.parseRound(RoundDoubleJeopardy, djTable, game.EpisodeNumber))
+	}
+	if hasRoundTJ {
+		tjTable := doc.Find("#triple_jeopardy_round")
+		game.Rounds = append(game.Rounds, p.parseRound(RoundTripleJeopardy, tjTable, game.EpisodeNumber))
+	}
+	if hasRoundFJ {
+		// For Final Jeopardy, use the first .final_round element.
+		fjTable := doc.Find("#final_jeopardy_round .final_round").First()
+		game.Rounds = append(game.Rounds, p.parseRound(RoundFinalJeopardy, fjTable, game.EpisodeNumber))
+	}
+	if hasRoundTB {
+		// For Tiebreaker, use the second .final_round element.
+		tbTable := doc.Find("#final_jeopardy_round .final_round").Eq(1)
+		game.Rounds = append(game.Rounds, p.parseRound(RoundTiebreaker, tbTable, game.EpisodeNumber))
+		game.TiebreakerWinner = tiebreakerWinner(doc)
+	}
+
+	if len(game.Rounds) == 0 {
+		return nil, &ParseError{Err: ErrNoRounds}
+	}
+	return game, nil
+}
+
+// reads the contestant lines at the top of the page
+func parseContestants(doc *goquery.Document) []Contestant {
+	var contestants []Contestant
+	doc.Find("#contestants p.contestants").Each(func(i int, s *goquery.Selection) {
+		if s.Find(`a[href*="player_id="]`).Length() > 1 {
+			contestants = append(contestants, parseTeam(s))
+			return
+		}
+		link := s.Find("a").First()
+		c := Contestant{Name: strings.TrimSpace(link.Text())}
+		if m := playerIDRe.FindStringSubmatch(link.AttrOr("href", "")); len(m) == 2 {
+			c.PlayerID = m[1]
+		}
+		// Everything after the name, minus the separating comma
+		c.Description = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(strings.TrimPrefix(s.Text(), link.Text())), ","))
+		if c.Name == "" {
+			c.Name = c.Description
+			c.Description = ""
+		}
+		contestants = append(contestants, c)
+	})
+	return contestants
+}
+
+// reads a team's line, such as "Team Ken: Ken Jennings (captain), Matt
+// Amodio and Mattea Roach", where each player has a showplayer.php link.
+// Teams without a name before the first player are named after their
+// players.
+func parseTeam(line *goquery.Selection) Contestant {
+	var team Contestant
+	lead := ""
+	line.Contents().Each(func(_ int, n *goquery.Selection) {
+		if goquery.NodeName(n) == "a" && playerIDRe.MatchString(n.AttrOr("href", "")) {
+			member := Contestant{Name: strings.TrimSpace(n.Text())}
+			member.PlayerID = playerIDRe.FindStringSubmatch(n.AttrOr("href", ""))[1]
+			team.Members = append(team.Members, member)
+			return
+		}
+		if len(team.Members) == 0 {
+			lead += n.Text()
+			return
+		}
+		team.Members[len(team.Members)-1].Description += n.Text()
+	})
+	var names []string
+	for i := range team.Members {
+		m := &team.Members[i]
+		names = append(names, m.Name)
+		// "(captain), " or " and "
+		m.Description = strings.TrimSpace(strings.Trim(teamSeparatorRe.ReplaceAllString(m.Description, ""), "()"))
+	}
+	team.Name = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(lead), ":"))
+	if team.Name == "" {
+		team.Name = strings.Join(names, " & ")
+	}
+	return team
+}
+
+// parses a round from the provided table selection
+func (p *Parser) parseRound(name string, table *goquery.Selection, epNum string) Round {
+	r := Round{Name: name}
+
+	switch name {
+	case RoundJeopardy, RoundDoubleJeopardy, RoundTripleJeopardy:
+		table.Find("td.category_name").Each(func(i int, s *goquery.Selection) {
+			r.Categories = append(r.Categories, p.plainText(s))
+		})
+		// Iterate over each clue, left to right then top to bottom
+		for _, cell := range boardCells(table, len(r.Categories)) {
+			s := cell.sel
+			category := ""
+			if cell.column <= len(r.Categories) {
+				category = r.Categories[cell.column-1]
+			}
+			clueText := strings.TrimSpace(s.Text())
+			if clueText == "" {
+				if p.opts.Unrevealed {
+					r.Clues = append(r.Clues, Clue{Round: r.Name, Category: category, Column: cell.column, Row: cell.row})
+				}
+				continue
+			}
+
+			// Get the raw value (monetary value) from a td whose class contains "clue_value".
+			valueRaw := strings.TrimSpace(s.Find("td[class*='clue_value']").Text())
+			// Get the question text
+			question := ""
+			s.Find("td.clue_text").EachWithBreak(func(i int, sel *goquery.Selection) bool {
+				if style, exists := sel.Attr("style"); !exists || !strings.Contains(style, "display:none") {
+					question = p.text(sel)
+					return false
+				}
+				return true
+			})
+
+			answer := ""
+			stumper := false
+			// Find the visible clue text from the container <td class="clue">
+			visibleClueTd := s.Find("td.clue_text").First()
+
+			if visibleClueTd.Length() > 0 {
+				// Get clue ID
+				clueID, exists := visibleClueTd.Attr("id")
+				if exists {
+					// Move up to the parent <tr> of the clue
+					tr := visibleClueTd.ParentsFiltered("tr")
+					if tr.Length() > 0 {
+						// Find the sibling hidden <td>
+						responseSel := tr.Find("td#" + clueID + "_r")
+						if responseSel.Length() > 0 {
+							answer = p.text(responseSel.Find("em.correct_response"))
+							stumper = tripleStumper(responseSel)
+						}
+					}
+				}
+			}
+
+			dailyDouble := strings.HasPrefix(valueRaw, "DD:")
+			clue := Clue{
+				Round:         r.Name,
+				Category:      category,
+				Value:         parseDollars(valueRaw),
+				ValueRaw:      valueRaw,
+				DailyDouble:   dailyDouble,
+				Question:      question,
+				Answer:        answer,
+				Revealed:      true,
+				TripleStumper: stumper && !dailyDouble,
+				Column:        cell.column,
+				Row:           cell.row,
+			}
+			r.Clues = append(r.Clues, clue)
+			debugClue(epNum, clue, "td#"+visibleClueTd.AttrOr("id", ""), valueRaw)
+		}
+		if p.opts.Unrevealed {
+			valueUnrevealed(r.Clues)
+		}
+	case RoundFinalJeopardy:
+		onmouseover, exists := table.Find("div[onmouseover]").Attr("onmouseover")
+		value := ""
+		if exists {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(onmouseover))
+			if err == nil {
+				// Collect text from <td> elements that have no attributes
+				var vals []string
+				doc.Find("td").Each(func(i int, s *goquery.Selection) {
+					vals = append(vals, strings.TrimSpace(s.Text()))
+				})
+				value = strings.Join(vals, ",")
+			}
+		}
+		answer := ""
+		stumper := false
+		responseSel := table.Find("td#clue_FJ_r")
+		if responseSel.Length() > 0 {
+			answer = p.text(responseSel.Find("em.correct_response"))
+			stumper = tripleStumper(responseSel)
+		}
+
+		category := p.plainText(table.Find("td.category_name"))
+		r.Categories = []string{category}
+		clue := Clue{
+			Round:         r.Name,
+			Category:      category,
+			ValueRaw:      value,
+			Question:      p.text(table.Find("td#clue_FJ")),
+			Answer:        answer,
+			Revealed:      true,
+			TripleStumper: stumper,
+		}
+		r.Clues = append(r.Clues, clue)
+		debugClue(epNum, clue, "td#clue_FJ", onmouseover)
+	case RoundTiebreaker:
+		answer := ""
+		stumper := false
+		onmouseover, exists := table.Find("div[onmouseover]").Attr("onmouseover")
+		if exists {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(onmouseover))
+			if err == nil {
+				answer = p.text(doc.Find("em"))
+				stumper = tripleStumper(doc.Selection)
+			}
+		}
+		category := p.plainText(table.Find("td.category_name"))
+		r.Categories = []string{category}
+		clue := Clue{
+			Round:         r.Name,
+			Category:      category,
+			Question:      p.text(table.Find("td#clue_TB")),
+			Answer:        answer,
+			Revealed:      true,
+			TripleStumper: stumper,
+		}
+		r.Clues = append(r.Clues, clue)
+		debugClue(epNum, clue, "td#clue_TB", "")
+	}
+
+	return r
+}
+
+// boardCell is a td.clue and its position on the board, counted from 1
+type boardCell struct {
+	sel         *goquery.Selection
+	column, row int
+}
+
+// returns the clue cells of a board, with the board's dimensions taken
+// from the table itself rather than assumed: each table row of clues is a
+// board row, and a cell's column is its place in that row, counting the
+// columns of any colspan before it. Boards whose cells all sit in one row
+// are wrapped at the number of categories. A clue's own ID, such as
+// clue_J_3_2 for column 3 row 2, overrides both, so a row missing a cell
+// doesn't shift the clues after it.
+func boardCells(table *goquery.Selection, categories int) []boardCell {
+	var cells []boardCell
+	var tr *html.Node
+	column, row := 0, 0
+	table.Find("td.clue").Each(func(_ int, td *goquery.Selection) {
+		if parent := td.Parent(); parent.Length() == 0 || parent.Get(0) != tr {
+			tr = parent.Get(0)
+			row++
+			column = 0
+		}
+		column++
+		cells = append(cells, boardCell{sel: td, column: column, row: row})
+		if span, err := strconv.Atoi(td.AttrOr("colspan", "")); err == nil && span > 1 {
+			column += span - 1
+		}
+	})
+	if row == 1 && categories > 0 && len(cells) > categories {
+		for i := range cells {
+			cells[i].column, cells[i].row = i%categories+1, i/categories+1
+		}
+	}
+	for i := range cells {
+		id := cells[i].sel.Find("td.clue_text").First().AttrOr("id", "")
+		if m := cluePositionRe.FindStringSubmatch(id); m != nil {
+			cells[i].column, _ = strconv.Atoi(m[1])
+			cells[i].row, _ = strconv.Atoi(m[2])
+		}
+	}
+	return cells
+}
+
+// reports whether a response lists contestants who got it wrong and none
+// who got it right. Regular clues nobody answered are listed as a wrong
+// "Triple Stumper"; pages without the contestant table give false.
+func tripleStumper(response *goquery.Selection) bool {
+	return response.Find("td.wrong").Length() > 0 && response.Find("td.right").Length() == 0
+}
+
+// gives unrevealed clues the value of the other clues in their board row;
+// Daily Doubles don't count since their value is the wager
+func valueUnrevealed(clues []Clue) {
+	rowValues := make(map[int]int)
+	for _, c := range clues {
+		if c.Revealed && !c.DailyDouble && c.Value != 0 {
+			rowValues[c.Row] = c.Value
+		}
+	}
+	for i := range clues {
+		if !clues[i].Revealed {
+			clues[i].Value = rowValues[clues[i].Row]
+		}
+	}
+}
+
+// turns a board value such as "$1,000" or "DD: $2,400" into whole dollars,
+// or 0 if there is no number in it
+func parseDollars(s string) int {
+	s = strings.TrimSpace(strings.TrimPrefix(s, "DD:"))
+	s = strings.ReplaceAll(strings.TrimPrefix(s, "$"), ",", "")
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
+// logs the selector a clue was read from and the values extracted from it;
+// only does any work when debug logging is enabled
+func debugClue(epNum string, c Clue, selector, valueRaw string) {
+	if !slog.Default().Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
+	slog.Debug("parsed clue", "epNum", epNum, "round", c.Round, "selector", selector,
+		"category", c.Category, "valueRaw", valueRaw, "value", c.Value, "dailyDouble", c.DailyDouble,
+		"question", c.Question, "answer", c.Answer)
+}
@@ -0,0 +1,74 @@
+package jarchive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// ErrNoPlayer means a player page has no name on it, which is what J!
+// Archive serves for unknown player ids
+var ErrNoPlayer = errors.New("no player found")
+
+// Player is a contestant as their showplayer.php page lists them
+type Player struct {
+	// J! Archive's player_id, from the first player link on the page; empty
+	// if it has none
+	PlayerID string
+	Name     string
+	// the games they played, in the order the page lists them
+	GameIDs []string
+}
+
+// parses a saved player page from disk. Errors from parsing are a
+// *ParseError with File set to path.
+func ParsePlayerFile(path string) (*Player, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	player, err := ParsePlayer(f)
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.File = path
+	}
+	return player, err
+}
+
+// parses a showplayer.php page from any reader. Errors are always a
+// *ParseError; a page without a player name fails with ErrNoPlayer.
+func ParsePlayer(r io.Reader) (*Player, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, &ParseError{Err: fmt.Errorf("invalid HTML: %w", err)}
+	}
+	p := &Player{}
+	for _, sel := range []string{".player_full_name", "#player_biography h1", "#content h1"} {
+		if p.Name = normalizeText(doc.Find(sel).First().Text()); p.Name != "" {
+			break
+		}
+	}
+	if p.Name == "" {
+		_, title, _ := strings.Cut(doc.Find("title").Text(), " - ")
+		p.Name = normalizeText(title)
+	}
+	if p.Name == "" {
+		return nil, &ParseError{Err: ErrNoPlayer}
+	}
+	if m := playerIDRe.FindStringSubmatch(doc.Find(`a[href*="player_id="]`).First().AttrOr("href", "")); len(m) == 2 {
+		p.PlayerID = m[1]
+	}
+	seen := make(map[string]bool)
+	doc.Find(`a[href*="showgame.php?game_id="]`).Each(func(_ int, a *goquery.Selection) {
+		if m := gameIDRe.FindStringSubmatch(a.AttrOr("href", "")); len(m) == 2 && !seen[m[1]] {
+			seen[m[1]] = true
+			p.GameIDs = append(p.GameIDs, m[1])
+		}
+	})
+	return p, nil
+}
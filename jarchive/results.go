@@ -0,0 +1,126 @@
+package jarchive
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// returns the "Final scores:" table under Final Jeopardy, an empty
+// selection if the page has none
+func finalScoresTable(doc *goquery.Document) *goquery.Selection {
+	if t := doc.Find("#final_scores table").First(); t.Length() > 0 {
+		return t
+	}
+	return doc.Find(`h3:contains("Final scores")`).First().NextAll().Filter("table").First()
+}
+
+// fills in the contestants' Nickname and FinalScore from the final scores
+// table, which names them by nickname: each goes to the contestant whose
+// name starts with it, and those that match nobody to the contestants left
+// over, in order
+func assignFinalScores(contestants []Contestant, table *goquery.Selection) {
+	rows := table.Find("tr")
+	if rows.Length() < 2 {
+		return
+	}
+	var nicknames []string
+	rows.Eq(0).Find("td, th").Each(func(_ int, c *goquery.Selection) {
+		nicknames = append(nicknames, normalizeText(c.Text()))
+	})
+	var scores []int
+	valid := true
+	rows.Eq(1).Find("td, th").Each(func(_ int, c *goquery.Selection) {
+		score, ok := parseScore(c.Text())
+		valid = valid && ok
+		scores = append(scores, score)
+	})
+	if !valid || len(scores) != len(nicknames) {
+		return
+	}
+
+	assigned := make([]bool, len(contestants))
+	var unmatched []int
+	for i, nick := range nicknames {
+		match := -1
+		for j, c := range contestants {
+			if !assigned[j] && namedAs(c.Name, nick) {
+				match = j
+				break
+			}
+		}
+		if match < 0 {
+			unmatched = append(unmatched, i)
+			continue
+		}
+		assigned[match] = true
+		contestants[match].Nickname = nick
+		contestants[match].FinalScore = &scores[i]
+	}
+	for j := range contestants {
+		if len(unmatched) == 0 {
+			break
+		}
+		if !assigned[j] {
+			i := unmatched[0]
+			unmatched = unmatched[1:]
+			contestants[j].Nickname = nicknames[i]
+			contestants[j].FinalScore = &scores[i]
+		}
+	}
+}
+
+// reports whether nick is how the scores refer to the contestant called
+// name: the whole name, or its first words
+func namedAs(name, nick string) bool {
+	name, nick = strings.ToLower(name), strings.ToLower(nick)
+	if nick == "" {
+		return false
+	}
+	return name == nick || strings.HasPrefix(name, nick+" ") || strings.HasPrefix(name, "team "+nick)
+}
+
+// a correct responder in a clue's response markup
+var rightRe = regexp.MustCompile(`class="right">([^<]+)<`)
+
+// returns the nickname of whoever gave the correct response to the
+// tiebreaker clue, from its response cell or, on older pages, the markup
+// its mouseover shows; empty if nobody did or there was no tiebreaker
+func tiebreakerWinner(doc *goquery.Document) string {
+	tb := doc.Find("#final_jeopardy_round .final_round").Eq(1)
+	if right := tb.Find("td.right").First(); right.Length() > 0 {
+		return normalizeText(right.Text())
+	}
+	if m := rightRe.FindStringSubmatch(tb.Find("[onmouseover]").AttrOr("onmouseover", "")); m != nil {
+		return normalizeText(m[1])
+	}
+	return ""
+}
+
+// returns the contestants with the highest final score, or none when the
+// page has no final scores or nobody finished above zero. A tie is settled
+// by the tiebreaker when there was one; otherwise, as before 2014, the tied
+// contestants all won.
+func (g *Game) Winners() []Contestant {
+	best := 0
+	var winners []Contestant
+	for _, c := range g.Contestants {
+		switch {
+		case c.FinalScore == nil || *c.FinalScore <= 0 || *c.FinalScore < best:
+		case *c.FinalScore > best:
+			best = *c.FinalScore
+			winners = []Contestant{c}
+		default:
+			winners = append(winners, c)
+		}
+	}
+	if len(winners) > 1 && g.TiebreakerWinner != "" {
+		for _, w := range winners {
+			if w.Nickname == g.TiebreakerWinner {
+				return []Contestant{w}
+			}
+		}
+	}
+	return winners
+}
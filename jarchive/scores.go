@@ -0,0 +1,170 @@
+package jarchive
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Scores is a game's score progression from its showscores.php page: every
+// player's score after each clue
+type Scores struct {
+	// J! Archive's game_id, from the page's link back to the game; empty if
+	// it has none
+	GameID string
+	// show number from the page title, e.g. "9000"
+	EpisodeNumber string
+	// the players as the page names them, usually by nickname, in the order
+	// of the score columns
+	Players []string
+	// rounds in the order they were played
+	Rounds []ScoreRound
+}
+
+// ScoreRound is the score progression through one round
+type ScoreRound struct {
+	Name string
+	// one entry per clue, in the order they were played
+	Clues []ScoreClue
+}
+
+// ScoreClue is the scores after one clue
+type ScoreClue struct {
+	// position in the round's play order, from 1
+	Order int
+	// every player's score after the clue, in Players order; negative when a
+	// player is in the red
+	Scores []int
+}
+
+// the round sections a scores page may have, in play order
+var scoreRounds = []struct{ id, name string }{
+	{"jeopardy_round", RoundJeopardy},
+	{"double_jeopardy_round", RoundDoubleJeopardy},
+	{"triple_jeopardy_round", RoundTripleJeopardy},
+	{"final_jeopardy_round", RoundFinalJeopardy},
+}
+
+// parses a saved scores page from disk. Errors from parsing are a
+// *ParseError with File set to path.
+func ParseScoresFile(path string) (*Scores, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scores, err := ParseScores(f)
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.File = path
+	}
+	return scores, err
+}
+
+// parses a showscores.php page from any reader. Errors are always a
+// *ParseError; a page without any score table fails with ErrNoRounds.
+func ParseScores(r io.Reader) (*Scores, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
+	if err != nil {
+		return nil, &ParseError{Err: fmt.Errorf("invalid HTML: %w", err)}
+	}
+	s := &Scores{}
+	if m := epNumRe.FindStringSubmatch(doc.Find("title").Text()); len(m) >= 2 {
+		s.EpisodeNumber = m[1]
+	}
+	self := doc.Find(`a[href*="showgame.php?game_id="]`).First()
+	if m := gameIDRe.FindStringSubmatch(self.AttrOr("href", "")); len(m) == 2 {
+		s.GameID = m[1]
+	}
+	for _, round := range scoreRounds {
+		table := doc.Find("#" + round.id + " table").First()
+		if table.Length() == 0 {
+			continue
+		}
+		players, sr := parseScoreTable(round.name, table)
+		if len(sr.Clues) == 0 {
+			continue
+		}
+		if s.Players == nil {
+			s.Players = players
+		}
+		if len(players) != len(s.Players) {
+			return nil, &ParseError{Round: round.name, Err: fmt.Errorf("%d players, %d in the rounds before", len(players), len(s.Players))}
+		}
+		s.Rounds = append(s.Rounds, sr)
+	}
+	if len(s.Rounds) == 0 {
+		return nil, &ParseError{Err: ErrNoRounds}
+	}
+	return s, nil
+}
+
+// reads a round's score table: a heading row naming the players, then a row
+// per clue of its number and each player's score. Rows that aren't scores,
+// such as totals with text in them, are skipped.
+func parseScoreTable(name string, table *goquery.Selection) ([]string, ScoreRound) {
+	var players []string
+	sr := ScoreRound{Name: name}
+	table.Find("tr").Each(func(i int, row *goquery.Selection) {
+		cells := row.Find("td, th")
+		if players == nil {
+			cells.Each(func(_ int, c *goquery.Selection) {
+				if text := normalizeText(c.Text()); text != "" && text != "#" {
+					players = append(players, text)
+				}
+			})
+			return
+		}
+		var texts []string
+		cells.Each(func(_ int, c *goquery.Selection) {
+			texts = append(texts, normalizeText(c.Text()))
+		})
+		// the clue number column; in Final Jeopardy there is none
+		order := len(sr.Clues) + 1
+		if len(texts) == len(players)+1 {
+			n, err := strconv.Atoi(texts[0])
+			if err != nil {
+				return
+			}
+			order, texts = n, texts[1:]
+		}
+		if len(texts) != len(players) {
+			return
+		}
+		clue := ScoreClue{Order: order}
+		for _, t := range texts {
+			score, ok := parseScore(t)
+			if !ok {
+				return
+			}
+			clue.Scores = append(clue.Scores, score)
+		}
+		sr.Clues = append(sr.Clues, clue)
+	})
+	return players, sr
+}
+
+// turns a score such as "$1,200", "-$400" or "−$400" into dollars
+func parseScore(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	negative := false
+	for _, minus := range []string{"-", "\u2212"} {
+		if rest, ok := strings.CutPrefix(s, minus); ok {
+			s, negative = rest, true
+		}
+	}
+	s = strings.ReplaceAll(strings.TrimPrefix(s, "$"), ",", "")
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	if negative {
+		n = -n
+	}
+	return n, true
+}
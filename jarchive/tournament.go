@@ -0,0 +1,97 @@
+package jarchive
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Tournament is the tournament or special event a game belongs to, as
+// the comments under the game title describe it
+type Tournament struct {
+	// e.g. "Tournament of Champions" or "Teen Tournament"
+	Name string
+	// "quarterfinal", "semifinal" or "final"; empty when the comments don't
+	// say, as for events without stages
+	Stage string
+	// the game's number within its stage (or the event), 0 if not given
+	Game int
+}
+
+// values for Tournament.Stage
+const (
+	StageQuarterfinal = "quarterfinal"
+	StageSemifinal    = "semifinal"
+	StageFinal        = "final"
+)
+
+// tournaments with stable names, checked in order; the first sentence of
+// the comments has to start with one of them, possibly after a year
+var tournaments = []struct {
+	re   *regexp.Regexp
+	name string
+}{
+	{regexp.MustCompile(`(?i)^ultimate tournament of champions\b`), "Ultimate Tournament of Champions"},
+	{regexp.MustCompile(`(?i)^tournament of champions\b`), "Tournament of Champions"},
+	{regexp.MustCompile(`(?i)^teen (tournament|jeopardy!?)`), "Teen Tournament"},
+	{regexp.MustCompile(`(?i)^(national )?college (championship|tournament)\b`), "College Championship"},
+	{regexp.MustCompile(`(?i)^celebrity (jeopardy!?|tournament|week)`), "Celebrity Jeopardy!"},
+	{regexp.MustCompile(`(?i)^jeopardy!? masters\b`), "Jeopardy! Masters"},
+	{regexp.MustCompile(`(?i)^battle of the decades\b`), "Battle of the Decades"},
+	{regexp.MustCompile(`(?i)^super jeopardy!?`), "Super Jeopardy!"},
+	{regexp.MustCompile(`(?i)^all-star games\b`), "All-Star Games"},
+}
+
+var (
+	// any other "<Capitalized Words> Tournament", e.g. "Teachers Tournament"
+	namedTournamentRe = regexp.MustCompile(`^((?:[A-Z][\w'-]*\s+)+Tournament)\b`)
+	// a year or "The" in front of the name
+	tournamentPrefixRe = regexp.MustCompile(`^(?:(?:19|20)\d\d\s+|[Tt]he\s+)+`)
+	stageRe            = regexp.MustCompile(`(?i)\b(quarter-?final|semi-?final|final)s?\b`)
+	stageGameRe        = regexp.MustCompile(`(?i)\b(?:game|day|match)\s+#?(\d+)\b`)
+)
+
+// works out the tournament from a game's comments, or nil for regular games
+func parseTournament(comments string) *Tournament {
+	first := tournamentPrefixRe.ReplaceAllString(firstSentence(comments), "")
+
+	t := &Tournament{}
+	rest := ""
+	for _, known := range tournaments {
+		if loc := known.re.FindStringIndex(first); loc != nil {
+			t.Name, rest = known.name, first[loc[1]:]
+			break
+		}
+	}
+	if t.Name == "" {
+		m := namedTournamentRe.FindStringSubmatch(first)
+		if m == nil {
+			return nil
+		}
+		t.Name, rest = strings.Join(strings.Fields(m[1]), " "), first[len(m[0]):]
+	}
+
+	if m := stageRe.FindStringSubmatch(rest); m != nil {
+		switch strings.ToLower(strings.ReplaceAll(m[1], "-", "")) {
+		case "quarterfinal":
+			t.Stage = StageQuarterfinal
+		case "semifinal":
+			t.Stage = StageSemifinal
+		default:
+			t.Stage = StageFinal
+		}
+	}
+	if m := stageGameRe.FindStringSubmatch(rest); m != nil {
+		t.Game, _ = strconv.Atoi(m[1])
+	}
+	return t
+}
+
+// returns comments up to the end of the first sentence or line
+func firstSentence(comments string) string {
+	first := strings.TrimSpace(comments)
+	if i := strings.IndexAny(first, ".\n"); i >= 0 {
+		first = first[:i]
+	}
+	return first
+}
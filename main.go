@@ -1,40 +1,144 @@
 package main
 
 import (
-	"flag"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
+)
 
-	"j-parser-go/download"
-	"j-parser-go/parse"
-	"strconv"
+// exit statuses
+const (
+	exitOK    = 0
+	exitFatal = 1
+	// bad flags or an unknown command
+	exitUsage = 2
+	// the run completed, but more episodes failed to parse than -max-errors
+	// allows, verify found episodes missing or validate found problems
+	exitEpisodesFailed = 3
+	// interrupted with Ctrl-C or SIGTERM
+	exitInterrupted = 130
 )
 
+// returns the status to exit with after a command returned err
+func exitCode(err error) int {
+	var failed *episodesFailedError
+	var missing *missingError
+	var invalid *invalidError
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.As(err, &failed), errors.As(err, &missing), errors.As(err, &invalid):
+		return exitEpisodesFailed
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	}
+	return exitFatal
+}
+
+// every subcommand, in the order they're listed in the help text
+var commands = []*command{
+	downloadCommand,
+	verifyCommand,
+	parseCommand,
+	syncCommand,
+	daemonCommand,
+	statsCommand,
+	categoriesCommand,
+	careersCommand,
+	duplicatesCommand,
+	exportCommand,
+	schemaCommand,
+	validateCommand,
+	diffCommand,
+	mergeCommand,
+	convertCommand,
+	queryCommand,
+	searchCommand,
+	filterCommand,
+	randomCommand,
+	sampleCommand,
+	playCommand,
+	indexCommand,
+	serveCommand,
+}
+
 func main() {
-	mode := flag.String("mode", "", "Mode: download or parse")
-	seasonsFlag := flag.String("seasons", "", "Comma-separated list of seasons to download (e.g., 1,2,3)")
-	flag.Parse()
-
-	switch *mode {
-	case "download":
-		seasons := []int{}
-		if *seasonsFlag != "" {
-			seasonStrings := strings.Split(*seasonsFlag, ",")
-			for _, s := range seasonStrings {
-				num, err := strconv.Atoi(strings.TrimSpace(s))
-				if err != nil {
-					fmt.Printf("Invalid season number: %s\n", s)
-					os.Exit(1)
-				}
-				seasons = append(seasons, num)
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(exitUsage)
+	}
+	name, args := os.Args[1], os.Args[2:]
+
+	// Older invocations used -mode=<name>, anywhere among the flags; keep
+	// them working for now
+	if strings.HasPrefix(name, "-") {
+		if mode, rest, ok := modeArg(os.Args[1:]); ok {
+			fmt.Fprintf(os.Stderr, "-mode is deprecated, use \"jarchive %s\" instead\n", mode)
+			name, args = mode, rest
+		}
+	}
+
+	switch name {
+	case "help", "-h", "-help", "--help":
+		if len(args) > 0 {
+			if cmd := lookup(args[0]); cmd != nil {
+				cmd.usage()
+				return
 			}
 		}
-		download.Run(seasons)
-	case "parse":
-		parse.Run()
-	default:
-		fmt.Println("Please specify a valid mode: -mode=download or -mode=parse")
-		os.Exit(1)
+		usage()
+		return
+	}
+
+	cmd := lookup(name)
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "jarchive: unknown command %q\n\n", name)
+		usage()
+		os.Exit(exitUsage)
+	}
+	if err := cmd.run(args); err != nil {
+		fmt.Fprintf(os.Stderr, "jarchive %s: %v\n", cmd.name, err)
+		os.Exit(exitCode(err))
+	}
+}
+
+// finds the -mode flag of an old-style invocation, as -mode=<name> or
+// -mode <name> (or with --), and returns its value and the other arguments
+func modeArg(args []string) (string, []string, bool) {
+	for i, arg := range args {
+		name := strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-")
+		if name == arg {
+			continue
+		}
+		if mode, ok := strings.CutPrefix(name, "mode="); ok {
+			return mode, slices.Concat(args[:i], args[i+1:]), true
+		}
+		if name == "mode" && i+1 < len(args) {
+			return args[i+1], slices.Concat(args[:i], args[i+2:]), true
+		}
+	}
+	return "", nil, false
+}
+
+func lookup(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: jarchive <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.summary)
 	}
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Run "jarchive help <command>" for the flags a command accepts.`)
 }
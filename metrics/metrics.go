@@ -0,0 +1,118 @@
+// Package metrics counts the requests, downloads and parses of a process
+// and serves them in the Prometheus text format, for monitoring the
+// long-running commands.
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// every metric, along with the Go runtime and process metrics
+var registry = prometheus.NewRegistry()
+
+var (
+	requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jarchive_requests_total",
+		Help: "Requests made to J! Archive, by method and status code (error if there was no response).",
+	}, []string{"method", "code"})
+	downloadedBytes = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jarchive_downloaded_bytes_total",
+		Help: "Bytes of response bodies read from J! Archive.",
+	})
+	gamesParsed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jarchive_games_parsed_total",
+		Help: "Episode pages parsed into games.",
+	})
+	parseFailures = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jarchive_parse_failures_total",
+		Help: "Episode pages that failed to parse.",
+	})
+	rateLimitWaits = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jarchive_rate_limit_waits_total",
+		Help: "Pauses between requests to J! Archive.",
+	})
+	rateLimitWaitSeconds = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "jarchive_rate_limit_wait_seconds_total",
+		Help: "Time spent pausing between requests to J! Archive.",
+	})
+	served = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "jarchive_served_requests_total",
+		Help: "Requests answered by serve, by status code.",
+	}, []string{"code"})
+)
+
+func init() {
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		requests, downloadedBytes, gamesParsed, parseFailures, rateLimitWaits, rateLimitWaitSeconds, served)
+}
+
+// returns the handler for /metrics
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// counts a game parsed from an episode page
+func GameParsed() {
+	gamesParsed.Inc()
+}
+
+// counts an episode page that failed to parse
+func ParseFailed() {
+	parseFailures.Inc()
+}
+
+// counts a pause of d between requests
+func Waited(d time.Duration) {
+	rateLimitWaits.Inc()
+	rateLimitWaitSeconds.Add(d.Seconds())
+}
+
+// returns a copy of c that counts its requests and the bytes it reads
+func Client(c *http.Client) *http.Client {
+	counted := *c
+	counted.Transport = transport{c.Transport}
+	return &counted
+}
+
+// transport counts the requests made through it
+type transport struct {
+	next http.RoundTripper
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		requests.WithLabelValues(req.Method, "error").Inc()
+		return nil, err
+	}
+	requests.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode)).Inc()
+	resp.Body = countingBody{resp.Body}
+	return resp, nil
+}
+
+// countingBody adds the bytes read from a response to downloadedBytes
+type countingBody struct {
+	io.ReadCloser
+}
+
+func (b countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	downloadedBytes.Add(float64(n))
+	return n, err
+}
+
+// wraps h to count the requests it answers
+func Served(h http.Handler) http.Handler {
+	return promhttp.InstrumentHandlerCounter(served, h)
+}
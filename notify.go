@@ -0,0 +1,85 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"j-parser-go/download"
+	"j-parser-go/notify"
+	"j-parser-go/parse"
+)
+
+// notifyFlags are shared by the commands that report how a run went when
+// they finish, to a webhook or a file
+type notifyFlags struct {
+	url     string
+	summary string
+	started time.Time
+}
+
+func registerNotifyFlags(fs *flag.FlagSet) *notifyFlags {
+	nf := &notifyFlags{started: time.Now()}
+	fs.StringVar(&nf.url, "notify-url", "", "POST a JSON summary of the run to this URL when it finishes, e.g. a Slack or Discord webhook")
+	fs.StringVar(&nf.summary, "summary", "", "Write a JSON summary of the run to this file when it finishes (- for standard output)")
+	return nf
+}
+
+// posts the summary of a finished run if -notify-url is set, and writes it
+// if -summary is. err is what the command is about to return; failing to
+// post or write is logged but doesn't fail the command.
+func (nf *notifyFlags) send(e *env, command string, err error, downloaded *download.Result, parsed *parse.Result) {
+	if e.fromConfig("notify-url") && e.cfg.NotifyURL != "" {
+		nf.url = e.cfg.NotifyURL
+	}
+	if e.fromConfig("summary") && e.cfg.Summary != "" {
+		nf.summary = e.cfg.Summary
+	}
+	if nf.url == "" && nf.summary == "" {
+		return
+	}
+	s := notify.Summary{Command: command, OK: err == nil, ExitCode: exitCode(err), Started: nf.started, Finished: time.Now()}
+	if err != nil {
+		s.Error = err.Error()
+	}
+	if downloaded != nil {
+		s.Seasons = downloaded.Seasons
+		s.Downloaded = downloaded.Downloaded
+		s.Skipped = downloaded.Skipped
+		s.Failed += downloaded.Failed
+		s.Errors = append(s.Errors, downloaded.Errors...)
+	}
+	if parsed != nil {
+		if s.Seasons == nil {
+			s.Seasons = parsed.Seasons
+		}
+		// runs of parse -watch after the first start long after the flags
+		// were read
+		if downloaded == nil && !parsed.Started.IsZero() {
+			s.Started = parsed.Started
+		}
+		s.Parsed = parsed.Parsed
+		s.Failed += parsed.Failed
+		s.Clues = parsed.Clues
+		for _, rec := range parsed.Errors {
+			s.Errors = append(s.Errors, fmt.Sprintf("season %s episode %s: %s", rec.Season, rec.EpNum, rec.Reason))
+		}
+	}
+	if nf.summary != "" {
+		path := nf.summary
+		if path == "-" {
+			path = ""
+		}
+		if err := writeOutput(path, func(w io.Writer) error { return notify.Write(w, s) }); err != nil {
+			slog.Error("error writing run summary", "file", nf.summary, "err", err)
+		}
+	}
+	if nf.url == "" {
+		return
+	}
+	if err := notify.Post(nf.url, s); err != nil {
+		slog.Error("error sending notification", "url", nf.url, "err", err)
+	}
+}
@@ -0,0 +1,123 @@
+// Package notify posts a summary of a finished download or parse run to a
+// webhook, such as a Slack or Discord incoming webhook, or writes it out for
+// scripts to read.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// how long to wait for the webhook to answer
+const timeout = 30 * time.Second
+
+// Summary is the JSON body posted when a run finishes
+type Summary struct {
+	// the command that ran, e.g. "parse"
+	Command string `json:"command"`
+	// false if the command failed; Error says why
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+	// the status the command exits with
+	ExitCode int `json:"exit_code"`
+	// the seasons downloaded or parsed
+	Seasons  []string  `json:"seasons"`
+	Started  time.Time `json:"started"`
+	Finished time.Time `json:"finished"`
+	// wall time from Started to Finished
+	Seconds    float64 `json:"seconds"`
+	Downloaded int     `json:"downloaded"`
+	// episodes already on disk that weren't downloaded again
+	Skipped int `json:"skipped"`
+	Parsed  int `json:"parsed"`
+	Failed  int `json:"failed"`
+	Clues   int `json:"clues"`
+	// one line per season or episode that failed
+	Errors []string `json:"errors"`
+}
+
+// the posted body: the summary and a one-line message in the fields Slack
+// (text) and Discord (content) show, so their webhooks take it as it is
+type message struct {
+	Summary
+	Text    string `json:"text"`
+	Content string `json:"content"`
+}
+
+// most errors listed in the message text; the rest are only counted
+const maxTextErrors = 5
+
+// returns the summary as a short message for people
+func (s Summary) Text() string {
+	var b strings.Builder
+	if s.OK {
+		fmt.Fprintf(&b, "jarchive %s finished in %s", s.Command, s.Finished.Sub(s.Started).Round(time.Second))
+	} else {
+		fmt.Fprintf(&b, "jarchive %s failed after %s: %s", s.Command, s.Finished.Sub(s.Started).Round(time.Second), s.Error)
+	}
+	fmt.Fprintf(&b, "\nseasons: %s", strings.Join(s.Seasons, ", "))
+	if s.Downloaded > 0 {
+		fmt.Fprintf(&b, "\nnew episodes downloaded: %d", s.Downloaded)
+	}
+	if s.Parsed > 0 {
+		fmt.Fprintf(&b, "\ngames parsed: %d (%d clues)", s.Parsed, s.Clues)
+	}
+	if s.Failed > 0 {
+		fmt.Fprintf(&b, "\nfailed: %d", s.Failed)
+	}
+	for i, e := range s.Errors {
+		if i == maxTextErrors {
+			fmt.Fprintf(&b, "\n... and %d more", len(s.Errors)-maxTextErrors)
+			break
+		}
+		fmt.Fprintf(&b, "\n- %s", e)
+	}
+	return b.String()
+}
+
+// fills in the fields derived from the others, so that lists are written
+// as [] rather than null
+func (s *Summary) complete() {
+	if s.Seasons == nil {
+		s.Seasons = []string{}
+	}
+	if s.Errors == nil {
+		s.Errors = []string{}
+	}
+	s.Seconds = s.Finished.Sub(s.Started).Seconds()
+}
+
+// writes s to w as indented JSON
+func Write(w io.Writer, s Summary) error {
+	s.complete()
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// posts s to url as JSON, failing unless the webhook answers with a 2xx
+// status
+func Post(url string, s Summary) error {
+	s.complete()
+	text := s.Text()
+	body, err := json.Marshal(message{s, text, text})
+	if err != nil {
+		return err
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error posting to %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("error posting to %s: %s: %s", url, resp.Status, strings.Join(strings.Fields(string(msg)), " "))
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package parse
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/jarchive"
+)
+
+// turns the regular fixture into CSV rows, as parse does for every episode:
+// parsing, normalizing, and flattening and sorting the clues
+func BenchmarkEpisodeRows(b *testing.B) {
+	fixture := filepath.Join("..", "jarchive", "testdata", "regular.html")
+	html, err := os.ReadFile(fixture)
+	if err != nil {
+		b.Fatal(err)
+	}
+	parser := &episodeParser{parser: jarchive.NewParser(jarchive.Options{})}
+	b.SetBytes(int64(len(html)))
+	for b.Loop() {
+		if _, err := parser.rowsFrom("regular", bytes.NewReader(html), fixture); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package parse
+
+import (
+	"bufio"
+	"encoding/csv"
+	"io"
+)
+
+// the UTF-8 byte order mark Options.BOM starts files with
+const utf8BOM = "\uFEFF"
+
+// csvFormat is how the CSV files of a run are encoded
+type csvFormat struct {
+	bom, crlf bool
+}
+
+// returns the encoding the options ask for
+func (o *Options) csvFormat() csvFormat {
+	return csvFormat{bom: o.BOM, crlf: o.CRLF}
+}
+
+// returns a CSV writer to w, writing the byte order mark first when the
+// format has one and w is a new file rather than one being appended to
+func (f csvFormat) writer(w io.Writer, newFile bool) *csv.Writer {
+	if f.bom && newFile {
+		io.WriteString(w, utf8BOM)
+	}
+	cw := csv.NewWriter(w)
+	cw.UseCRLF = f.crlf
+	return cw
+}
+
+// returns r without the UTF-8 byte order mark it may start with, for
+// reading CSVs written with Options.BOM
+func SkipBOM(r io.Reader) io.Reader {
+	br := bufio.NewReader(r)
+	if b, err := br.Peek(len(utf8BOM)); err == nil && string(b) == utf8BOM {
+		br.Discard(len(utf8BOM))
+	}
+	return br
+}
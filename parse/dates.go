@@ -0,0 +1,51 @@
+package parse
+
+import (
+	"strconv"
+	"time"
+)
+
+// the columns Options.DateFields adds
+var dateHeader = []string{"air_year", "air_weekday", "season_week"}
+
+// returns the date columns of a game that aired on airDate in a season
+// whose first episode aired on premiere: the year, the weekday and the
+// week of the season, counting Monday to Sunday weeks from the one the
+// premiere aired in. Each is empty when the dates it needs are missing.
+func dateFields(airDate, premiere string) []string {
+	d, err := time.Parse(time.DateOnly, airDate)
+	if err != nil {
+		return []string{"", "", ""}
+	}
+	week := ""
+	if p, err := time.Parse(time.DateOnly, premiere); err == nil && !d.Before(p) {
+		monday := p.AddDate(0, 0, -(int(p.Weekday())+6)%7)
+		week = strconv.Itoa(int(d.Sub(monday).Hours()/24)/7 + 1)
+	}
+	return []string{strconv.Itoa(d.Year()), d.Weekday().String(), week}
+}
+
+// returns the air date of a season's first episode, the one with the
+// lowest show number that has an air date, read from the archive once per
+// season; empty when the season has none
+func (p *episodeParser) premiere(season string) string {
+	if !p.cols.dates {
+		return ""
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if d, ok := p.premieres[season]; ok {
+		return d
+	}
+	if p.premieres == nil {
+		p.premieres = make(map[string]string)
+	}
+	episodes, _ := seasonEpisodes(p.opts, season)
+	for _, file := range episodes {
+		if game, err := p.parser.ParseFile(file); err == nil && game.AirDate != "" {
+			p.premieres[season] = game.AirDate
+			break
+		}
+	}
+	return p.premieres[season]
+}
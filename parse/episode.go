@@ -0,0 +1,94 @@
+package parse
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"j-parser-go/jarchive"
+	"j-parser-go/metrics"
+	"j-parser-go/tracing"
+)
+
+// spans of episode parses
+var tracer = tracing.Tracer("j-parser-go/parse")
+
+// episodeParser parses episode pages for one run
+type episodeParser struct {
+	parser *jarchive.Parser
+	// optional columns of the rows
+	cols columns
+	// J! Archive game ids from the download manifest, for pages that don't
+	// link to their own
+	gameIDs map[episodeKey]string
+	// where the seasons' episodes are, and the air date of each season's
+	// first episode, found by premiere
+	opts      Options
+	mu        sync.Mutex
+	premieres map[string]string
+}
+
+// episodeKey is an episode as the download manifest lists it
+type episodeKey struct{ season, episode string }
+
+// parses an episode file into the CSV rows it contributes, sorted by category and value
+func (p *episodeParser) rows(season, file string) ([][]string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return p.rowsFrom(season, f, file)
+}
+
+// like rows but reads the HTML from r; name is only used in errors and to
+// find the game id
+func (p *episodeParser) rowsFrom(season string, r io.Reader, name string) ([][]string, error) {
+	game, err := p.gameFrom(season, r, name)
+	if err != nil {
+		return nil, err
+	}
+	return gameRows(season, game, p.cols, p.premiere(season)), nil
+}
+
+// parses an episode file into a game
+func (p *episodeParser) game(season, file string) (*jarchive.Game, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return p.gameFrom(season, f, file)
+}
+
+// parses a game page, naming the file in parse errors and filling in the
+// game id from the manifest when the page doesn't have one
+func (p *episodeParser) gameFrom(season string, r io.Reader, name string) (*jarchive.Game, error) {
+	episode := strings.TrimSuffix(filepath.Base(name), ".html")
+	_, span := tracer.Start(context.Background(), "parse episode", trace.WithAttributes(attribute.String("season", season),
+		attribute.String("episode", episode), attribute.String("file", name)))
+	game, err := p.parser.ParseGame(r)
+	var pe *jarchive.ParseError
+	if errors.As(err, &pe) {
+		pe.File = name
+	}
+	if err != nil {
+		metrics.ParseFailed()
+		tracing.End(span, tracing.Failed, err)
+		return nil, err
+	}
+	metrics.GameParsed()
+	if game.GameID == "" {
+		game.GameID = p.gameIDs[episodeKey{season, episode}]
+	}
+	span.SetAttributes(attribute.String("game_id", game.GameID), attribute.Int("clues", len(game.Clues())))
+	tracing.End(span, tracing.Parsed, nil)
+	return game, nil
+}
@@ -0,0 +1,165 @@
+package parse
+
+import (
+	"bytes"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"j-parser-go/download"
+	"j-parser-go/internal/golden"
+	"j-parser-go/jarchive"
+)
+
+// runs the jarchive fixtures through the CSV row pipeline, each as a season
+// named after it, and compares the output, header included, with
+// testdata/<name>.golden.csv
+func TestGoldenRows(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fixtures) == 0 {
+		t.Fatal("no fixtures in ../jarchive/testdata")
+	}
+	parser := &episodeParser{parser: jarchive.NewParser(jarchive.Options{})}
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		t.Run(name, func(t *testing.T) {
+			rows, err := parser.rows(name, fixture)
+			if err != nil {
+				t.Fatalf("rows: %v", err)
+			}
+			var buf bytes.Buffer
+			w := csv.NewWriter(&buf)
+			w.Write(csvHeader)
+			w.WriteAll(rows)
+			if err := w.Error(); err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("testdata", name+".golden.csv")
+			got := buf.Bytes()
+			golden.Compare(t, path, got)
+		})
+	}
+}
+
+// runs the jarchive fixtures, one season each, into the normalized tables
+// and compares each table with testdata/normalized/<table>.golden.csv
+func TestGoldenTables(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := &episodeParser{parser: jarchive.NewParser(jarchive.Options{})}
+	tables := newTables(columns{})
+	for _, fixture := range fixtures {
+		season := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		game, err := parser.game(season, fixture)
+		if err != nil {
+			t.Fatalf("game: %v", err)
+		}
+		tables.addGame(season, game)
+	}
+	dir := t.TempDir()
+	if err := tables.write(dir, csvFormat{}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range normalizedFiles {
+		t.Run(name, func(t *testing.T) {
+			got, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("testdata", "normalized", strings.TrimSuffix(name, ".csv")+".golden.csv")
+			golden.Compare(t, path, got)
+		})
+	}
+}
+
+// parses testdata/seasons/season-page.html as a cached season page with
+// TargetSeasons and compares seasons.csv with
+// testdata/seasons/seasons.golden.csv
+func TestGoldenSeasonPages(t *testing.T) {
+	page, err := os.ReadFile(filepath.Join("testdata", "seasons", "season-page.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	archive := t.TempDir()
+	cached := download.SeasonPageFile(archive, "40")
+	if err := os.MkdirAll(filepath.Dir(cached), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(cached, page, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{ArchiveDir: archive, OutDir: t.TempDir(), Target: TargetSeasons, Quiet: true, NoProgress: true}
+	if _, err := Run(opts); err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(filepath.Join(opts.OutDir, seasonsFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join("testdata", "seasons", "seasons.golden.csv")
+	golden.Compare(t, path, got)
+}
+
+// runs the jarchive fixtures through the CSV row pipeline with the
+// difficulty column and compares the rows of all of them with
+// testdata/difficulty/rows.golden.csv
+func TestGoldenDifficulty(t *testing.T) {
+	checkColumnsGolden(t, columns{difficulty: true}, nil, filepath.Join("testdata", "difficulty", "rows.golden.csv"))
+}
+
+// the same with the value_normalized and value_adjusted columns, against
+// testdata/values/rows.golden.csv
+func TestGoldenValues(t *testing.T) {
+	checkColumnsGolden(t, columns{valueNormalized: true, valueAdjusted: true}, nil, filepath.Join("testdata", "values", "rows.golden.csv"))
+}
+
+// the same with the date columns, against testdata/dates/rows.golden.csv.
+// The fixtures aren't in season folders, so some are given a premiere and
+// the others have no season_week.
+func TestGoldenDates(t *testing.T) {
+	premieres := map[string]string{"regular": "2023-09-11", "old-era": "1994-09-05", "daily-doubles": "2019-09-09"}
+	checkColumnsGolden(t, columns{dates: true}, premieres, filepath.Join("testdata", "dates", "rows.golden.csv"))
+}
+
+// the same with line breaks escaped, against
+// testdata/newlines/rows.golden.csv
+func TestGoldenNewlines(t *testing.T) {
+	checkColumnsGolden(t, columns{newlines: NewlinesEscape}, nil, filepath.Join("testdata", "newlines", "rows.golden.csv"))
+}
+
+// runs every jarchive fixture through the CSV row pipeline with the
+// optional columns and newline mode of cols and each fixture's premiere,
+// and compares the rows with the golden file at path
+func checkColumnsGolden(t *testing.T, cols columns, premieres map[string]string, path string) {
+	t.Helper()
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := Options{Newlines: cols.newlines}
+	parser := &episodeParser{parser: opts.parser(), cols: cols, premieres: make(map[string]string)}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(cols.header(csvHeader))
+	for _, fixture := range fixtures {
+		name := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		parser.premieres[name] = premieres[name]
+		rows, err := parser.rows(name, fixture)
+		if err != nil {
+			t.Fatalf("%s: rows: %v", name, err)
+		}
+		w.WriteAll(rows)
+	}
+	if err := w.Error(); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.Bytes()
+	golden.Compare(t, path, got)
+}
@@ -0,0 +1,272 @@
+package parse
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"j-parser-go/jarchive"
+)
+
+// directory under the output directory holding one state file per season
+const stateFolder = ".state"
+
+// seasonState is what an incremental run remembers about a season CSV: the
+// options it was written with, the CSV itself and every episode in it, in
+// the order their rows appear
+type seasonState struct {
+	Options    string         `json:"options"`
+	CSVSize    int64          `json:"csv_size"`
+	CSVModTime time.Time      `json:"csv_mod_time"`
+	Episodes   []episodeState `json:"episodes"`
+}
+
+// episodeState identifies the version of an episode file whose rows are in
+// the CSV. Size and modification time are checked first; the hash catches
+// files that were touched but not changed.
+type episodeState struct {
+	File    string    `json:"file"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	SHA256  string    `json:"sha256"`
+	Rows    int       `json:"rows"`
+	// set when the episode failed to parse; it has no rows and is reported
+	// again without re-parsing until the file changes
+	Failed *ErrorRecord `json:"failed,omitempty"`
+}
+
+// incremental works out which episodes of a season can keep the rows already
+// in its CSV and records the new state as the season is written
+type incremental struct {
+	opts   Options
+	season string
+	// rows from the existing CSV for episodes that haven't changed, by file
+	reuse map[string][][]string
+	// state of each episode, by file, for unchanged ones and ones parsed so far
+	current map[string]episodeState
+	// number of leading episodes whose rows are already in the CSV in the
+	// right place, so that only the rest needs appending
+	keep  int
+	order []string
+}
+
+// returns the state file for a season
+func statePath(opts Options, season string) string {
+	return filepath.Join(opts.OutDir, stateFolder, fmt.Sprintf("season-%s.json", season))
+}
+
+// identifies everything besides the episodes that affects a CSV's contents
+func stateOptions(opts Options) string {
+	s := fmt.Sprintf("%s;raw_text=%t;markdown=%t", strings.Join(opts.header(), ","), opts.RawText, opts.Markdown)
+	// only when set, so states saved before these existed still match
+	if opts.BOM {
+		s += ";bom"
+	}
+	if opts.CRLF {
+		s += ";crlf"
+	}
+	if opts.Newlines != "" && opts.Newlines != NewlinesSpace {
+		s += ";newlines=" + opts.Newlines
+	}
+	if (opts.ValueNormalized || opts.Difficulty) && (opts.ValueNormalizedCutoff != "" && opts.ValueNormalizedCutoff != jarchive.DoubledValuesDate ||
+		opts.valueFactor() != jarchive.DoubledValuesFactor) {
+		s += fmt.Sprintf(";value_normalized=%s*%d", opts.ValueNormalizedCutoff, opts.valueFactor())
+	}
+	return s
+}
+
+// loads the season's state and compares it with the episode files on disk.
+// Any mismatch with the CSV itself (missing, edited, different options)
+// means nothing can be reused.
+func newIncremental(opts Options, season, csvFile string, episodes []string) *incremental {
+	inc := &incremental{
+		opts:    opts,
+		season:  season,
+		reuse:   make(map[string][][]string),
+		current: make(map[string]episodeState),
+	}
+	st, err := loadSeasonState(opts, season, csvFile)
+	if err != nil {
+		slog.Info("re-parsing whole season", "season", season, "reason", err)
+		return inc
+	}
+	cached, err := readCachedRows(csvFile, st)
+	if err != nil {
+		slog.Info("re-parsing whole season", "season", season, "reason", err)
+		return inc
+	}
+
+	prev := make(map[string]episodeState)
+	for _, e := range st.Episodes {
+		prev[e.File] = e
+	}
+	inPlace := true
+	for i, file := range episodes {
+		e, ok := prev[file]
+		unchanged := ok && inc.unchanged(file, e)
+		if unchanged {
+			inc.reuse[file] = cached[file]
+		}
+		// the CSV can only be appended to while the episodes on disk start
+		// with exactly the ones already written, in the same order
+		if inPlace && i < len(st.Episodes) && st.Episodes[i].File == file && unchanged {
+			inc.keep = i + 1
+		} else {
+			inPlace = false
+		}
+	}
+	if inc.keep != len(st.Episodes) {
+		inc.keep = 0
+	}
+	return inc
+}
+
+func loadSeasonState(opts Options, season, csvFile string) (*seasonState, error) {
+	data, err := os.ReadFile(statePath(opts, season))
+	if err != nil {
+		return nil, err
+	}
+	var st seasonState
+	if err := json.Unmarshal(data, &st); err != nil {
+		return nil, fmt.Errorf("error decoding state: %v", err)
+	}
+	if st.Options != stateOptions(opts) {
+		return nil, errors.New("options changed")
+	}
+	info, err := os.Stat(csvFile)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != st.CSVSize || !info.ModTime().Equal(st.CSVModTime) {
+		return nil, errors.New("CSV changed since the last run")
+	}
+	return &st, nil
+}
+
+// reads the existing CSV back and splits its rows by episode
+func readCachedRows(csvFile string, st *seasonState) (map[string][][]string, error) {
+	f, err := os.Open(csvFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	r := csv.NewReader(f)
+	if _, err := r.Read(); err != nil {
+		return nil, fmt.Errorf("error reading CSV header: %v", err)
+	}
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error reading CSV: %v", err)
+	}
+	cached := make(map[string][][]string)
+	for _, e := range st.Episodes {
+		if e.Rows > len(rows) {
+			return nil, errors.New("CSV has fewer rows than recorded")
+		}
+		cached[e.File], rows = rows[:e.Rows:e.Rows], rows[e.Rows:]
+	}
+	if len(rows) > 0 {
+		return nil, errors.New("CSV has more rows than recorded")
+	}
+	return cached, nil
+}
+
+// reports whether file still matches e, remembering its current state if so
+func (inc *incremental) unchanged(file string, e episodeState) bool {
+	info, err := os.Stat(file)
+	if err != nil {
+		return false
+	}
+	if info.Size() == e.Size && info.ModTime().Equal(e.ModTime) {
+		inc.current[file] = e
+		return true
+	}
+	if info.Size() != e.Size {
+		return false
+	}
+	sum, err := fileHash(file)
+	if err != nil || sum != e.SHA256 {
+		return false
+	}
+	e.ModTime = info.ModTime()
+	inc.current[file] = e
+	return true
+}
+
+// returns the rows already in the CSV for an unchanged episode, or the
+// failure it is known to produce; ok is always false when the run isn't
+// incremental
+func (inc *incremental) cached(file string) (rows [][]string, failed *ErrorRecord, ok bool) {
+	if inc == nil {
+		return nil, nil, false
+	}
+	rows, ok = inc.reuse[file]
+	return rows, inc.current[file].Failed, ok
+}
+
+// reports whether every episode is unchanged and already in the CSV, in
+// which case the CSV doesn't need touching at all
+func (inc *incremental) upToDate(episodes []string) bool {
+	return inc.keep == len(episodes) && len(episodes) > 0
+}
+
+// records a freshly parsed episode; rec is set if it failed
+func (inc *incremental) parsed(file string, rows int, rec *ErrorRecord) {
+	e := episodeState{File: file, Rows: rows, Failed: rec}
+	if info, err := os.Stat(file); err == nil {
+		e.Size, e.ModTime = info.Size(), info.ModTime()
+	}
+	e.SHA256, _ = fileHash(file)
+	inc.current[file] = e
+}
+
+// records that the episode's rows were written to the CSV, in order
+func (inc *incremental) written(file string) {
+	inc.order = append(inc.order, file)
+}
+
+// writes the season's state once its CSV has been closed
+func (inc *incremental) save(csvFile string) {
+	st := seasonState{Options: stateOptions(inc.opts)}
+	for _, file := range inc.order {
+		st.Episodes = append(st.Episodes, inc.current[file])
+	}
+	info, err := os.Stat(csvFile)
+	if err != nil {
+		slog.Error("error reading CSV for state", "season", inc.season, "file", csvFile, "err", err)
+		return
+	}
+	st.CSVSize, st.CSVModTime = info.Size(), info.ModTime()
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err == nil {
+		path := statePath(inc.opts, inc.season)
+		if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err == nil {
+			err = os.WriteFile(path, data, 0o644)
+		}
+	}
+	if err != nil {
+		slog.Error("error writing parse state", "season", inc.season, "err", err)
+	}
+}
+
+func fileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
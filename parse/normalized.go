@@ -0,0 +1,277 @@
+package parse
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"j-parser-go/internal/logging"
+	"j-parser-go/jarchive"
+)
+
+// files of the normalized layout, relative to Options.OutDir
+var normalizedFiles = []string{"games.csv", "rounds.csv", "categories.csv", "clues.csv", "contestants.csv"}
+
+// tables is the normalized layout: each game, round and category once, with
+// clues and contestants pointing at them by ID
+type tables struct {
+	cols        columns
+	games       [][]string
+	rounds      [][]string
+	categories  [][]string
+	clues       [][]string
+	contestants [][]string
+	// category IDs by name
+	categoryIDs map[string]int
+	// the air date of each season's first game, as games are added in show
+	// number order
+	premieres map[string]string
+}
+
+func newTables(cols columns) *tables {
+	t := &tables{
+		cols:        cols,
+		games:       [][]string{{"game_id", "jarchive_game_id", "season", "epNum", "airDate", "tournament", "tournament_stage", "tournament_game", "host", "game_format"}},
+		rounds:      [][]string{{"round_id", "game_id", "round_number", "round_name"}},
+		categories:  [][]string{{"category_id", "category"}},
+		clues:       [][]string{{"clue_id", "game_id", "round_id", "category_id", "value", "value_raw", "daily_double", "board_column", "board_row", "question", "clue_notes", "answer", "answer_normalized", "triple_stumper"}},
+		contestants: [][]string{{"game_id", "position", "team", "player_id", "name", "description"}},
+		categoryIDs: make(map[string]int),
+		premieres:   make(map[string]string),
+	}
+	t.clues[0] = cols.header(t.clues[0])
+	return t
+}
+
+// adds a game with its rounds, clues and contestants. Game, round and clue
+// IDs are jarchive's stable IDs; category IDs count up from 1 in the order
+// categories are added.
+func (t *tables) addGame(season string, game *jarchive.Game) {
+	gameID := jarchive.StableGameID(game.GameID, season, game.EpisodeNumber)
+	if t.premieres[season] == "" {
+		t.premieres[season] = game.AirDate
+	}
+	row := append([]string{gameID, game.GameID, season, game.EpisodeNumber, game.AirDate}, tournamentFields(game.Tournament)...)
+	t.games = append(t.games, append(row, game.Host, game.Format))
+	for i, r := range game.Rounds {
+		t.rounds = append(t.rounds, []string{jarchive.RoundID(game.GameID, season, game.EpisodeNumber, r.Name), gameID, strconv.Itoa(i + 1), r.Name})
+	}
+	for i, c := range game.Contestants {
+		position := strconv.Itoa(i + 1)
+		if c.Members == nil {
+			t.contestants = append(t.contestants, []string{gameID, position, "", c.PlayerID, c.Name, c.Description})
+		}
+		// a team's players share its position
+		for _, m := range c.Members {
+			t.contestants = append(t.contestants, []string{gameID, position, c.Name, m.PlayerID, m.Name, m.Description})
+		}
+	}
+	clues := game.Clues()
+	sortClues(clues)
+	for _, clue := range clues {
+		id, ok := t.categoryIDs[clue.Category]
+		if !ok {
+			id = len(t.categories)
+			t.categoryIDs[clue.Category] = id
+			t.categories = append(t.categories, []string{strconv.Itoa(id), t.cols.text(clue.Category)})
+		}
+		value := ""
+		if clue.Value != 0 {
+			value = strconv.Itoa(clue.Value)
+		}
+		row := []string{jarchive.ClueID(game.GameID, season, game.EpisodeNumber, clue), gameID, jarchive.RoundID(game.GameID, season, game.EpisodeNumber, clue.Round), strconv.Itoa(id),
+			value, clue.ValueRaw, strconv.FormatBool(clue.DailyDouble), boardPosition(clue.Column), boardPosition(clue.Row),
+			t.cols.text(clue.Question), t.cols.text(clue.Notes), t.cols.text(clue.Answer), jarchive.NormalizeAnswer(clue.Answer), strconv.FormatBool(clue.TripleStumper)}
+		t.clues = append(t.clues, t.cols.fields(row, clue, game.AirDate, t.premieres[season]))
+	}
+}
+
+// returns the tables in normalizedFiles' order
+func (t *tables) all() [][][]string {
+	return [][][]string{t.games, t.rounds, t.categories, t.clues, t.contestants}
+}
+
+// writes each table to its file in dir
+func (t *tables) write(dir string, format csvFormat) error {
+	for i, rows := range t.all() {
+		if err := writeCSVFile(filepath.Join(dir, normalizedFiles[i]), rows, format); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writes rows to path through a partial file that replaces it once complete
+func writeCSVFile(path string, rows [][]string, format csvFormat) error {
+	f, err := os.Create(path + partialSuffix)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %v", path, err)
+	}
+	w := format.writer(f, true)
+	w.WriteAll(rows)
+	if err := errors.Join(w.Error(), f.Close()); err != nil {
+		os.Remove(path + partialSuffix)
+		return fmt.Errorf("error writing %s: %v", path, err)
+	}
+	if err := os.Rename(path+partialSuffix, path); err != nil {
+		return fmt.Errorf("error replacing %s: %v", path, err)
+	}
+	return nil
+}
+
+// Run with LayoutNormalized: parses every selected season, then writes the
+// tables with the games in season and show number order
+func runNormalized(ctx context.Context, opts Options) (Result, error) {
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return Result{}, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
+	}
+	seasons, prog, err := parseGames(ctx, opts)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		all := make([]string, len(seasons))
+		for i, s := range seasons {
+			all[i] = s.Season
+		}
+		return cancelRun(opts, prog, all), err
+	}
+	t := newTables(opts.columns())
+	for _, s := range seasons {
+		for _, game := range s.Games {
+			t.addGame(s.Season, game)
+		}
+	}
+	if err := t.write(opts.OutDir, opts.csvFormat()); err != nil {
+		return Result{}, err
+	}
+	return finishRun(opts, prog), nil
+}
+
+// SeasonGames is a season's games as parsed from the archive
+type SeasonGames struct {
+	Season string
+	// in show number order, without the episodes that failed to parse
+	Games []*jarchive.Game
+}
+
+// parses every selected season into games without writing anything, for
+// exports that need more of a game than the CSVs have, such as its
+// contestants. OutDir isn't used.
+func Games(opts Options) ([]SeasonGames, error) {
+	opts.setDefaults()
+	seasons, prog, err := parseGames(context.Background(), opts)
+	if err != nil {
+		return nil, err
+	}
+	res := prog.result()
+	slog.Info("parsing complete", "episodes", res.Episodes, "parsed", res.Parsed, "failed", res.Failed, "clues", res.Clues)
+	return seasons, nil
+}
+
+// parses every selected season into games, seasons in order, stopping
+// between episodes once ctx is done
+func parseGames(ctx context.Context, opts Options) ([]SeasonGames, *progress, error) {
+	seasons, err := selectedSeasons(opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prog := newProgress(!opts.NoProgress)
+	if prog.line.Enabled() {
+		defer logging.Redirect(prog.line)()
+	}
+	parser := opts.episodeParser()
+	stages := newPipeline(opts.Concurrency)
+	slog.Info("starting parse", "threads", opts.Concurrency, "seasons", len(seasons), "layout", opts.Layout)
+	games := make([]SeasonGames, len(seasons))
+	for i, season := range seasons {
+		games[i].Season = season
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+	for i, season := range seasons {
+		wg.Add(1)
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			wg.Done()
+			break
+		}
+		go func() {
+			defer wg.Done()
+			games[i].Games = parseSeasonGames(ctx, season, opts, prog, parser, stages)
+			<-sem
+		}()
+	}
+	wg.Wait()
+	stages.close()
+	prog.finish()
+	return games, prog, nil
+}
+
+// parses a season's episodes into games through the pipeline, in show
+// number order, leaving out the ones that fail
+func parseSeasonGames(ctx context.Context, season string, opts Options, prog *progress, parser *episodeParser, stages *pipeline) []*jarchive.Game {
+	var games []*jarchive.Game
+	err := streamSeasonGames(ctx, season, opts, prog, parser, stages, func(_ string, game *jarchive.Game, err error) error {
+		if err == nil {
+			games = append(games, game)
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() == nil {
+		slog.Error("error reading season directory", "season", season, "dir", seasonPath(opts, season), "err", err)
+	}
+	return games
+}
+
+// parses a season's episodes through the pipeline, handing each to fn in
+// show number order as soon as it is parsed: the game, or the error for
+// episodes that fail, which are logged and counted as well. It stops at the
+// first error fn returns, and returns it, or ctx's error once it is done,
+// or the error listing the season's episodes.
+func streamSeasonGames(ctx context.Context, season string, opts Options, prog *progress, parser *episodeParser, stages *pipeline,
+	fn func(file string, game *jarchive.Game, err error) error) error {
+	slog.Info("starting season", "season", season)
+	episodes, err := seasonEpisodes(opts, season)
+	if err != nil {
+		return err
+	}
+	prog.addSeason(season, len(episodes))
+	parsed := parseInOrder(stages, episodes, func(file string, body []byte) (*jarchive.Game, error) {
+		return parser.gameFrom(season, bytes.NewReader(body), file)
+	})
+	for _, episodePath := range episodes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		game, err := parsed.get(episodePath)
+		if err != nil {
+			slog.Error("error parsing episode", "season", season,
+				"epNum", strings.TrimSuffix(filepath.Base(episodePath), ".html"), "file", episodePath, "err", err)
+			prog.episodeFailed(season, episodePath, err)
+		} else {
+			clues := 0
+			for _, c := range game.Clues() {
+				if c.Revealed {
+					clues++
+				}
+			}
+			prog.episodeParsed(season, clues)
+		}
+		if err := fn(episodePath, game, err); err != nil {
+			return err
+		}
+	}
+	stats := prog.stats(season)
+	slog.Info("season complete", "season", season, "episodes", stats.episodes,
+		"parsed", stats.parsed, "clues", stats.clues, "failed", stats.failed)
+	return nil
+}
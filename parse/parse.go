@@ -1,19 +1,23 @@
 package parse
 
 import (
-	"encoding/csv"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
-	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/PuerkitoBio/goquery"
+	"j-parser-go/download"
+	"j-parser-go/internal/logging"
+	"j-parser-go/jarchive"
 )
 
 var (
@@ -21,343 +25,757 @@ var (
 	csvFolder  = "parsed-csv"
 )
 
-func Run() {
-	// Create CSV folder if it doesn't exist
-	if err := os.MkdirAll(csvFolder, os.ModePerm); err != nil {
-		log.Fatalf("Error creating CSV folder: %v", err)
+// first line of every season CSV
+var csvHeader = []string{"clue_id", "season", "game_id", "epNum", "airDate", "round_name", "category", "value", "value_raw", "daily_double", "board_column", "board_row", "question", "clue_notes", "answer", "answer_normalized", "triple_stumper", "tournament", "tournament_stage", "tournament_game", "host", "game_format"}
+
+// Options controls how Run reports its progress
+type Options struct {
+	// don't draw the progress display
+	NoProgress bool
+	// don't print the summary table at the end
+	Quiet bool
+	// directory holding the downloaded season folders, "season-archive" if empty
+	ArchiveDir string
+	// directory the CSVs are written to, "parsed-csv" if empty
+	OutDir string
+	// number of episodes parsed at once, shared by all seasons, and of
+	// seasons written at once; twice the CPU count if zero
+	Concurrency int
+	// keep clue text as extracted instead of normalizing entities, quotes
+	// and whitespace
+	RawText bool
+	// keep emphasis and links in clues and responses as Markdown
+	Markdown bool
+	// write a row for every unrevealed clue too, and a "revealed" column
+	Unrevealed bool
+	// add a "value_normalized" column with each clue's board value scaled
+	// to the post-doubling scale, see jarchive.Clue.NormalizedValue
+	ValueNormalized bool
+	// the air date (YYYY-MM-DD) before which ValueNormalized and Difficulty
+	// multiply values, and by how much; jarchive.DoubledValuesDate and
+	// jarchive.DoubledValuesFactor if empty or nil. A factor that isn't
+	// positive is an error.
+	ValueNormalizedCutoff string
+	ValueNormalizedFactor *int
+	// add a "value_adjusted" column with each clue's value adjusted for
+	// inflation, see jarchive.Clue.AdjustedValue
+	ValueAdjusted bool
+	// add "air_year", "air_weekday" and "season_week" columns derived from
+	// the air date
+	DateFields bool
+	// start every CSV with a UTF-8 byte order mark, and end its lines with
+	// CRLF, so Excel on Windows opens it with accented characters intact
+	BOM, CRLF bool
+	// what becomes of the line breaks of <br> tags in categories, clues and
+	// responses: NewlinesSpace (the default) turns them into spaces,
+	// NewlinesPreserve keeps them in quoted fields and NewlinesEscape writes
+	// them as the two characters \n, with backslashes doubled
+	Newlines string
+	// add a "difficulty" column grading each clue from 0 to 1, see
+	// jarchive.Clue.Difficulty
+	Difficulty bool
+	// only parse these seasons; every season in the archive if empty
+	Seasons []string
+	// seasons to leave alone, applied after Seasons
+	SkipSeasons []string
+	// only re-parse episodes that are new or changed since the last
+	// incremental run, tracked in a .state folder in OutDir
+	Incremental bool
+	// LayoutFlat (the default) for one CSV per season, or LayoutNormalized
+	// for games, categories, clues and contestants tables
+	Layout string
+	// TargetGames (the default) to parse the episodes; TargetSeasons to
+	// parse the cached season pages into seasons.csv, TargetScores the
+	// scores pages into scores.csv or TargetPlayers the player pages into
+	// players.csv, instead
+	Target string
+}
+
+// values for Options.Layout
+const (
+	LayoutFlat       = "flat"
+	LayoutNormalized = "normalized"
+)
+
+// values for Options.Newlines
+const (
+	NewlinesSpace    = "space"
+	NewlinesPreserve = "preserve"
+	NewlinesEscape   = "escape"
+)
+
+// values for Options.Target
+const (
+	TargetGames   = "games"
+	TargetSeasons = "seasons"
+	TargetScores  = "scores"
+	TargetPlayers = "players"
+)
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.ArchiveDir == "" {
+		o.ArchiveDir = siteFolder
+	}
+	if o.OutDir == "" {
+		o.OutDir = csvFolder
+	}
+	if o.Concurrency <= 0 {
+		o.Concurrency = runtime.NumCPU() * 2
+	}
+	if o.Layout == "" {
+		o.Layout = LayoutFlat
 	}
+	if o.Target == "" {
+		o.Target = TargetGames
+	}
+	if o.Newlines == "" {
+		o.Newlines = NewlinesSpace
+	}
+	if o.ValueNormalizedCutoff == "" {
+		o.ValueNormalizedCutoff = jarchive.DoubledValuesDate
+	}
+}
 
-	// Get list of season numbers
-	seasons, err := getAllSeasons()
-	if err != nil {
-		log.Fatalf("Error getting seasons: %v", err)
+// the factor ValueNormalized multiplies values by
+func (o *Options) valueFactor() int {
+	if o.ValueNormalizedFactor == nil {
+		return jarchive.DoubledValuesFactor
 	}
+	return *o.ValueNormalizedFactor
+}
 
-	// Use goroutines to parse seasons concurrently
-	numThreads := runtime.NumCPU() * 2
-	fmt.Printf("Using %d threads for parsing seasons\n", numThreads)
-	var wg sync.WaitGroup
-	sem := make(chan struct{}, numThreads)
-	for _, season := range seasons {
-		wg.Add(1)
-		sem <- struct{}{}
-		go func(season int) {
-			defer wg.Done()
-			parseSeason(season)
-			<-sem
-		}(season)
+// rejects unknown layouts and newline modes, value_normalized cutoffs that
+// aren't dates and factors that aren't positive, and options the normalized layout can't honour
+func (o *Options) checkLayout() error {
+	if _, err := time.Parse(time.DateOnly, o.ValueNormalizedCutoff); o.ValueNormalizedCutoff != "" && err != nil {
+		return fmt.Errorf("value_normalized cutoff %q isn't a YYYY-MM-DD date", o.ValueNormalizedCutoff)
 	}
-	wg.Wait()
-	fmt.Println("Parsing complete.")
+	if f := o.valueFactor(); f <= 0 {
+		return fmt.Errorf("value_normalized factor %d isn't positive", f)
+	}
+	switch o.Newlines {
+	case NewlinesSpace, NewlinesPreserve, NewlinesEscape:
+	default:
+		return fmt.Errorf("unknown newline mode %q (want %s, %s or %s)", o.Newlines, NewlinesSpace, NewlinesPreserve, NewlinesEscape)
+	}
+	switch o.Layout {
+	case LayoutFlat:
+		return nil
+	case LayoutNormalized:
+		if o.Incremental {
+			return errors.New("incremental parsing only works with the flat layout")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown layout %q (want %s or %s)", o.Layout, LayoutFlat, LayoutNormalized)
 }
 
-// returns slice of season numbers found in the siteFolder
-func getAllSeasons() ([]int, error) {
-	var seasons []int
-	entries, err := os.ReadDir(siteFolder)
-	if err != nil {
-		return nil, err
+// added to a season CSV's name while it is being rewritten
+const partialSuffix = ".partial"
+
+// columns are the optional columns a run adds to the clues, and how it
+// writes their text
+type columns struct {
+	unrevealed, valueNormalized, valueAdjusted, difficulty, dates bool
+	// Options.Newlines; empty is NewlinesSpace
+	newlines string
+	// Options.ValueNormalizedCutoff and ValueNormalizedFactor, for the
+	// value_normalized and difficulty columns; unset are jarchive's
+	// defaults
+	cutoff string
+	factor int
+}
+
+// returns the optional columns the options ask for
+func (o *Options) columns() columns {
+	return columns{unrevealed: o.Unrevealed, valueNormalized: o.ValueNormalized, valueAdjusted: o.ValueAdjusted, difficulty: o.Difficulty, dates: o.DateFields,
+		newlines: o.Newlines, cutoff: o.ValueNormalizedCutoff, factor: o.valueFactor()}
+}
+
+// writes escaped line breaks, and the backslashes that would make them
+// ambiguous
+var newlineEscaper = strings.NewReplacer(`\`, `\\`, "\r\n", `\n`, "\r", `\n`, "\n", `\n`)
+
+// turns the \n and \\ of text written with NewlinesEscape back into line
+// breaks and backslashes, so it reads as it was on the page. Other
+// backslashes are left as they are.
+func UnescapeNewlines(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
 	}
-	// extract season number from directory names
-	re := regexp.MustCompile(`\d+`)
-	for _, entry := range entries {
-		if entry.IsDir() {
-			match := re.FindString(entry.Name())
-			if match != "" {
-				if num, err := strconv.Atoi(match); err == nil {
-					seasons = append(seasons, num)
-				}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) && (s[i+1] == 'n' || s[i+1] == '\\') {
+			i++
+			if s[i] == 'n' {
+				b.WriteByte('\n')
+				continue
 			}
 		}
+		b.WriteByte(s[i])
 	}
-	return seasons, nil
+	return b.String()
 }
 
-// processes all HTML files and writes to a CSV
-func parseSeason(season int) {
-	fmt.Printf("Starting season %d\n", season)
-	seasonDir := filepath.Join(siteFolder, fmt.Sprintf("season %d", season))
-	entries, err := os.ReadDir(seasonDir)
-	if err != nil {
-		log.Printf("Error reading season directory %s: %v", seasonDir, err)
-		return
+// turns line breaks into spaces, the ones raw text keeps from the page
+// source too
+var newlineSpacer = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// returns a category, clue or response as the newline mode writes it
+func (c columns) text(s string) string {
+	switch c.newlines {
+	case NewlinesPreserve:
+		return s
+	case NewlinesEscape:
+		return newlineEscaper.Replace(s)
 	}
+	return newlineSpacer.Replace(s)
+}
 
-	// Create CSV file for this season
-	csvPath := filepath.Join(csvFolder, fmt.Sprintf("j-archive-season-%d.csv", season))
-	csvFile, err := os.Create(csvPath)
-	if err != nil {
-		log.Printf("Error creating CSV file %s: %v", csvPath, err)
-		return
+// appends the optional columns' names to a header. revealed always comes
+// last, which is how revealedClues finds it.
+func (c columns) header(header []string) []string {
+	header = header[:len(header):len(header)]
+	if c.valueNormalized {
+		header = append(header, "value_normalized")
 	}
-	defer csvFile.Close()
-	writer := csv.NewWriter(csvFile)
-	defer writer.Flush()
-
-	// Write CSV header
-	header := []string{"epNum", "airDate", "round_name", "category", "value", "daily_double", "question", "answer"}
-	writer.Write(header)
+	if c.valueAdjusted {
+		header = append(header, "value_adjusted")
+	}
+	if c.difficulty {
+		header = append(header, "difficulty")
+	}
+	if c.dates {
+		header = append(header, dateHeader...)
+	}
+	if c.unrevealed {
+		header = append(header, "revealed")
+	}
+	return header
+}
 
-	for i, entry := range entries {
-		if entry.IsDir() {
-			continue
+// appends the optional columns of a clue to its row, for a game that
+// aired on airDate in a season that premiered on premiere
+func (c columns) fields(row []string, clue jarchive.Clue, airDate, premiere string) []string {
+	if c.valueNormalized {
+		v := ""
+		if n := clue.ScaledValue(airDate, c.cutoff, c.factor); n != 0 {
+			v = strconv.Itoa(n)
 		}
-		episodePath := filepath.Join(seasonDir, entry.Name())
-		fmt.Printf("Season %d: Parsing episode %d/%d\n", season, i+1, len(entries))
-		rounds, err := parseEpisode(episodePath)
-		if err != nil {
-			log.Printf("Error parsing episode %s: %v", episodePath, err)
-			continue
+		row = append(row, v)
+	}
+	if c.valueAdjusted {
+		v := ""
+		if n, ok := clue.AdjustedValue(airDate); ok {
+			v = strconv.Itoa(n)
 		}
-		// Collect all rows from this episode
-		var episodeRows [][]string
-		for _, round := range rounds {
-			episodeRows = append(episodeRows, round...)
+		row = append(row, v)
+	}
+	if c.difficulty {
+		d := ""
+		if v, ok := clue.ScaledDifficulty(airDate, c.cutoff, c.factor); ok {
+			d = strconv.FormatFloat(v, 'f', 2, 64)
 		}
+		row = append(row, d)
+	}
+	if c.dates {
+		row = append(row, dateFields(airDate, premiere)...)
+	}
+	if c.unrevealed {
+		row = append(row, strconv.FormatBool(clue.Revealed))
+	}
+	return row
+}
 
-		// Sort rows first by category then by value
-		sort.Slice(episodeRows, func(i, j int) bool {
-			// First group by category
-			if episodeRows[i][3] == episodeRows[j][3] {
-				valueI := episodeRows[i][4]
-				valueJ := episodeRows[j][4]
-
-				// Check if a clue is a Daily Double
-				isDD_I := strings.HasPrefix(valueI, "DD:")
-				isDD_J := strings.HasPrefix(valueJ, "DD:")
-
-				// If one clue is a DD and the other isn't, the non-DD clue comes first
-				if isDD_I != isDD_J {
-					return !isDD_I
-				}
+// returns the CSV header for the options' columns
+func (o *Options) header() []string {
+	return o.columns().header(csvHeader)
+}
 
-				// Remove "DD:" prefix for comparison
-				if isDD_I {
-					valueI = strings.TrimSpace(strings.TrimPrefix(valueI, "DD:"))
-				}
-				if isDD_J {
-					valueJ = strings.TrimSpace(strings.TrimPrefix(valueJ, "DD:"))
-				}
+// returns the game page parser configured by the options
+func (o *Options) parser() *jarchive.Parser {
+	return jarchive.NewParser(jarchive.Options{RawText: o.RawText, Markdown: o.Markdown, Unrevealed: o.Unrevealed,
+		LineBreaks: o.Newlines != "" && o.Newlines != NewlinesSpace})
+}
 
-				// Remove any $ sign and commas
-				valueI = strings.ReplaceAll(strings.TrimPrefix(valueI, "$"), ",", "")
-				valueJ = strings.ReplaceAll(strings.TrimPrefix(valueJ, "$"), ",", "")
+// returns the episode parser for the options, with the game ids from the
+// archive's download manifest
+func (o *Options) episodeParser() *episodeParser {
+	p := &episodeParser{parser: o.parser(), cols: o.columns(), gameIDs: make(map[episodeKey]string), opts: *o}
+	entries, err := download.ReadManifest(o.ArchiveDir)
+	if err != nil {
+		slog.Warn("not using the download manifest for game ids", "err", err)
+	}
+	for _, e := range entries {
+		p.gameIDs[episodeKey{e.Season, e.Episode}] = e.GameID
+	}
+	return p
+}
 
-				// Convert to integer
-				vi, err1 := strconv.Atoi(valueI)
-				vj, err2 := strconv.Atoi(valueJ)
-				if err1 == nil && err2 == nil {
-					return vi < vj
-				}
-				// Fall back to string comparison if conversion fails
-				return valueI < valueJ
-			}
-			// Sort by category name.
-			return episodeRows[i][3] < episodeRows[j][3]
-		})
+// parses every season in the archive directory into one CSV per season, or
+// the normalized tables with LayoutNormalized, and returns the totals.
+// Episodes that fail to parse are skipped and listed in errors.json /
+// errors.csv in the output directory.
+func Run(opts Options) (Result, error) {
+	return RunContext(context.Background(), opts)
+}
 
-		// Write rows to the CSV
-		for _, row := range episodeRows {
-			writer.Write(row)
-		}
+// like Run, but once ctx is done it finishes the episodes under way, stops
+// and returns the totals so far, with Result.Unfinished listing the seasons
+// it didn't get to the end of, and ctx's error. An incremental run renames
+// what it wrote of a season over its CSV and saves the state, so the next
+// one carries on from there; otherwise a season that was being rewritten
+// keeps its previous CSV. The normalized tables aren't written at all, and
+// neither is the error report.
+func RunContext(ctx context.Context, opts Options) (Result, error) {
+	opts.setDefaults()
+	switch opts.Target {
+	case TargetGames:
+	case TargetSeasons:
+		return runSeasonPages(opts)
+	case TargetScores:
+		return runScores(opts)
+	case TargetPlayers:
+		return runPlayers(opts)
+	default:
+		return Result{}, fmt.Errorf("unknown target %q (want %s, %s, %s or %s)", opts.Target, TargetGames, TargetSeasons, TargetScores, TargetPlayers)
+	}
+	if err := opts.checkLayout(); err != nil {
+		return Result{}, err
+	}
+	if opts.Layout == LayoutNormalized {
+		return runNormalized(ctx, opts)
+	}
 
+	// Create CSV folder if it doesn't exist
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return Result{}, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
 	}
-	fmt.Printf("Season %d complete\n", season)
-}
 
-// parses an episode HTML file and returns data organized by Jeopardy round (Jeopardy, Double Jeopardy, Final Jeopardy)
-// returns slice where each element is a round (a slice of rows, and each row is a []string)
-func parseEpisode(filePath string) ([][][]string, error) {
-	f, err := os.Open(filePath)
+	// Get list of season identifiers
+	seasons, err := selectedSeasons(opts)
 	if err != nil {
-		return nil, err
+		return Result{}, err
 	}
-	defer f.Close()
 
-	doc, err := goquery.NewDocumentFromReader(f)
-	if err != nil {
-		return nil, err
+	// Use goroutines to parse seasons concurrently
+	prog := newProgress(!opts.NoProgress)
+	if prog.line.Enabled() {
+		defer logging.Redirect(prog.line)()
 	}
 
-	// Extract episode number from the <title>.
-	titleText := doc.Find("title").Text()
-	reEpNum := regexp.MustCompile(`#(\d+)`)
-	epNum := ""
-	if m := reEpNum.FindStringSubmatch(titleText); len(m) >= 2 {
-		epNum = m[1]
+	// Episodes from every season go through one pipeline: read, then
+	// parsed on the shared workers, then written by their season's
+	// goroutine, up to as many of which run at a time
+	parser := opts.episodeParser()
+	stages := newPipeline(opts.Concurrency)
+	parse := func(season string, files []string) func(string) ([][]string, error) {
+		return parseInOrder(stages, files, func(file string, body []byte) ([][]string, error) {
+			return parser.rowsFrom(season, bytes.NewReader(body), file)
+		}).get
+	}
+	slog.Info("starting parse", "threads", opts.Concurrency, "seasons", len(seasons))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, opts.Concurrency)
+	for _, season := range seasons {
+		wg.Add(1)
+		sem <- struct{}{}
+		if ctx.Err() != nil {
+			wg.Done()
+			break
+		}
+		go func(season string) {
+			defer wg.Done()
+			parseSeason(ctx, season, opts, prog, parse)
+			<-sem
+		}(season)
+	}
+	wg.Wait()
+	stages.close()
+	prog.finish()
+	if err := ctx.Err(); err != nil {
+		return cancelRun(opts, prog, prog.unfinished(seasons)), err
 	}
+	return finishRun(opts, prog), nil
+}
 
-	// Extract air date (YYYY-MM-DD) from the title
-	reAirDate := regexp.MustCompile(`\d{4}-\d{2}-\d{2}`)
-	airDate := reAirDate.FindString(titleText)
+// prints the summary of a run stopped part way through, with how to pick
+// up the unfinished seasons, and returns its totals, leaving the error
+// report of the last complete run alone
+func cancelRun(opts Options, prog *progress, unfinished []string) Result {
+	res := prog.result()
+	res.Unfinished = unfinished
+	slog.Warn("parsing cancelled", "episodes", res.Episodes, "parsed", res.Parsed, "failed", res.Failed, "clues", res.Clues,
+		"unfinished", unfinished)
+	if opts.Quiet {
+		return res
+	}
+	prog.writeSummary(os.Stdout)
+	seasons := "seasons " + strings.Join(unfinished, ", ")
+	if len(unfinished) == 1 {
+		seasons = "season " + unfinished[0]
+	}
+	switch {
+	case len(unfinished) == 0:
+	case opts.Layout == LayoutNormalized:
+		fmt.Println("Interrupted before the tables were written; run again to write them.")
+	case opts.Incremental:
+		fmt.Printf("Interrupted with %s unfinished; run again with -incremental to carry on where this run stopped.\n", seasons)
+	default:
+		fmt.Printf("Interrupted with %s unfinished, left as they were; run again with -seasons=%s to parse them.\n",
+			seasons, strings.Join(unfinished, ","))
+	}
+	return res
+}
 
-	hasRoundJ := doc.Find("#jeopardy_round").Length() > 0
-	hasRoundDJ := doc.Find("#double_jeopardy_round").Length() > 0
-	hasRoundFJ := doc.Find("#final_jeopardy_round").Length() > 0
-	hasRoundTB := doc.Find("#final_jeopardy_round .final_round").Length() > 1
+// prints the summary, writes the error report and returns the run's totals
+func finishRun(opts Options, prog *progress) Result {
+	res := prog.result()
+	slog.Info("parsing complete", "episodes", res.Episodes, "parsed", res.Parsed, "failed", res.Failed, "clues", res.Clues)
+	if !opts.Quiet {
+		prog.writeSummary(os.Stdout)
+	}
+	if err := writeErrorReport(opts.OutDir, res.Errors, opts.csvFormat()); err != nil {
+		slog.Error("error writing error report", "dir", opts.OutDir, "err", err)
+	} else if res.Failed > 0 {
+		slog.Warn("some episodes failed to parse", "count", res.Failed,
+			"report", filepath.Join(opts.OutDir, errorsJSONFile))
+	}
+	if err := writeSchema(opts); err != nil {
+		slog.Error("error writing schema", "dir", opts.OutDir, "err", err)
+	}
+	return res
+}
 
-	var rounds [][][]string
+// returns slice of season identifiers found in the archive directory, numbered
+// seasons first in numeric order followed by named seasons alphabetically
+func getAllSeasons(archiveDir string) ([]string, error) {
+	return download.SeasonDirs(archiveDir)
+}
 
-	if hasRoundJ {
-		jTable := doc.Find("#jeopardy_round")
-		rows := parseRound(0, jTable, epNum, airDate)
-		rounds = append(rounds, rows)
+// returns the archive's seasons narrowed down by opts.Seasons and
+// opts.SkipSeasons
+func selectedSeasons(opts Options) ([]string, error) {
+	all, err := getAllSeasons(opts.ArchiveDir)
+	if err != nil {
+		return nil, fmt.Errorf("error getting seasons: %v", err)
+	}
+	if len(opts.Seasons) == 0 && len(opts.SkipSeasons) == 0 {
+		return all, nil
 	}
-	if hasRoundDJ {
-		djTable := doc.Find("#double_jeopardy_round")
-		rows := parseRound(1, djTable, epNum, airDate)
-		rounds = append(rounds, rows)
+	inArchive := make(map[string]bool)
+	for _, s := range all {
+		inArchive[s] = true
 	}
-	if hasRoundFJ {
-		// For Final Jeopardy, use the first .final_round element.
-		fjTable := doc.Find("#final_jeopardy_round .final_round").First()
-		rows := parseRound(2, fjTable, epNum, airDate)
-		rounds = append(rounds, rows)
+	want := make(map[string]bool)
+	for _, s := range opts.Seasons {
+		if !inArchive[s] {
+			slog.Warn("season not in archive, skipping", "season", s, "dir", opts.ArchiveDir)
+		}
+		want[s] = true
+	}
+	skip := make(map[string]bool)
+	for _, s := range opts.SkipSeasons {
+		skip[s] = true
 	}
-	if hasRoundTB {
-		// For Tiebreaker, use the second .final_round element.
-		tbTable := doc.Find("#final_jeopardy_round .final_round").Eq(1)
-		rows := parseRound(3, tbTable, epNum, airDate)
-		rounds = append(rounds, rows)
+	var seasons []string
+	for _, s := range all {
+		if (len(want) == 0 || want[s]) && !skip[s] {
+			seasons = append(seasons, s)
+		}
 	}
+	return seasons, nil
+}
+
+// seasonParser starts parsing the given episode files of a season and
+// returns a function handing back each one's rows, to be called with the
+// files in the same order. Run queues them on its worker pool; Syncer
+// reuses the episodes it has already parsed.
+type seasonParser func(season string, files []string) func(file string) ([][]string, error)
 
-	if len(rounds) == 0 {
-		return nil, fmt.Errorf("no rounds found in episode %s", filePath)
+// processes all HTML files and writes to a CSV, parsing them with parse
+func parseSeason(ctx context.Context, season string, opts Options, prog *progress, parse seasonParser) {
+	slog.Info("starting season", "season", season)
+	episodes, err := seasonEpisodes(opts, season)
+	if err != nil {
+		slog.Error("error reading season directory", "season", season, "dir", seasonPath(opts, season), "err", err)
+		return
 	}
-	return rounds, nil
+	writeSeason(ctx, season, opts, prog, episodes, parse)
 }
 
-// parses a game round from the provided table selection and returns rows of the CSV
-func parseRound(round int, table *goquery.Selection, epNum, airDate string) [][]string {
-	var rows [][]string
+// returns the paths of a season's episode files in show number order
+func seasonEpisodes(opts Options, season string) ([]string, error) {
+	seasonDir := seasonPath(opts, season)
+	entries, err := os.ReadDir(seasonDir)
+	if err != nil {
+		return nil, err
+	}
+	var episodes []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".html" {
+			episodes = append(episodes, filepath.Join(seasonDir, entry.Name()))
+		}
+	}
+	sortEpisodes(episodes)
+	return episodes, nil
+}
 
-	if round < 2 {
-		// Get category names for Jeopardy (round==0) or Double Jeopardy (round==1).
-		var categories []string
-		table.Find("td.category_name").Each(func(i int, s *goquery.Selection) {
-			categories = append(categories, strings.TrimSpace(s.Text()))
-		})
-		x := 0
-		// Iterate over each clue
-		table.Find("td.clue").Each(func(i int, s *goquery.Selection) {
-			clueText := strings.TrimSpace(s.Text())
-			if clueText == "" {
-				// Skip empty clues, assuming 6 categories per round
-				x = (x + 1) % 6
-				return
-			}
+// orders episode files by show number, so 99.html comes before 100.html
+// whatever order the filesystem lists them in; files not named after a
+// number follow in name order
+func sortEpisodes(episodes []string) {
+	sort.SliceStable(episodes, func(i, j int) bool {
+		a := strings.TrimSuffix(filepath.Base(episodes[i]), ".html")
+		b := strings.TrimSuffix(filepath.Base(episodes[j]), ".html")
+		return download.SeasonLess(a, b)
+	})
+}
+
+// returns the archive directory holding a season's episode files
+func seasonPath(opts Options, season string) string {
+	return filepath.Join(opts.ArchiveDir, fmt.Sprintf("season %s", season))
+}
 
-			// Get the raw value (monetary value) from a td whose class contains "clue_value".
-			valueRaw := strings.TrimSpace(s.Find("td[class*='clue_value']").Text())
-			value := ""
-			if valueRaw != "" {
-				v := strings.ReplaceAll(strings.TrimPrefix(valueRaw, "D: $"), ",", "")
-				v = strings.TrimPrefix(v, "$")
-				value = v
-			} else {
-				value = "-100"
+// writes the season's CSV from the given episode paths, in order, using
+// parse to get each episode's rows. With opts.Incremental episodes that
+// haven't changed since the last run keep their rows, and a CSV that only
+// gains new episodes is appended to rather than rewritten. Once ctx is done
+// it stops before the next episode, leaving what it wrote so far.
+func writeSeason(ctx context.Context, season string, opts Options, prog *progress, episodes []string, parse seasonParser) {
+	prog.addSeason(season, len(episodes))
+	outPath := csvPath(opts, season)
+
+	var inc *incremental
+	if opts.Incremental {
+		inc = newIncremental(opts, season, outPath, episodes)
+		if inc.upToDate(episodes) {
+			for _, episodePath := range episodes {
+				if rows, failed, _ := inc.cached(episodePath); failed != nil {
+					prog.failure(season, *failed)
+				} else {
+					prog.episodeParsed(season, revealedClues(rows, opts))
+				}
 			}
-			// Determine if clue is a Daily Double
-			dailyDouble := "false"
-			if strings.HasPrefix(valueRaw, "DD:") {
-				dailyDouble = "true"
+			prog.seasonDone(season)
+			slog.Info("season up to date", "season", season, "episodes", len(episodes))
+			// touched but unchanged files get their new times recorded
+			for _, episodePath := range episodes {
+				inc.written(episodePath)
 			}
-			// Get the question text
-			question := ""
-			s.Find("td.clue_text").EachWithBreak(func(i int, sel *goquery.Selection) bool {
-				if style, exists := sel.Attr("style"); !exists || !strings.Contains(style, "display:none") {
-					question = strings.TrimSpace(sel.Text())
-					return false
-				}
-				return true
-			})
-
-			// Extract answer from onmouseover attribute
-			answer := ""
-			// Find the visible clue text from the container <td class="clue">
-			visibleClueTd := s.Find("td.clue_text").First()
-
-			if visibleClueTd.Length() > 0 {
-				// Get clue ID
-				clueID, exists := visibleClueTd.Attr("id")
-				if exists {
-					// Move up to the parent <tr> of the clue
-					tr := visibleClueTd.ParentsFiltered("tr")
-					if tr.Length() > 0 {
-						// Find the sibling hidden <td>
-						responseSel := tr.Find("td#" + clueID + "_r")
-						if responseSel.Length() > 0 {
-							answer = strings.TrimSpace(responseSel.Find("em.correct_response").Text())
-						}
-					}
-				}
+			inc.save(outPath)
+			return
+		}
+	}
+
+	// Rewrite the season into a partial file that replaces the CSV once
+	// complete, so an interrupted run leaves the last complete CSV alone,
+	// or reopen the CSV to add new episodes
+	appending := inc != nil && inc.keep > 0
+	writePath := outPath + partialSuffix
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appending {
+		writePath = outPath
+		flags = os.O_WRONLY | os.O_APPEND
+	}
+	csvFile, err := os.OpenFile(writePath, flags, 0o644)
+	if err != nil {
+		slog.Error("error creating CSV file", "season", season, "file", writePath, "err", err)
+		return
+	}
+	writer := opts.csvFormat().writer(csvFile, !appending)
+	// flushes what has been written so far to the file
+	flush := func() bool {
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			slog.Error("error writing CSV file", "season", season, "file", writePath, "err", err)
+			csvFile.Close()
+			if !appending {
+				os.Remove(writePath)
 			}
+			return false
+		}
+		return true
+	}
+
+	// Write CSV header
+	if !appending {
+		writer.Write(opts.header())
+	}
 
-			category := ""
-			if x < len(categories) {
-				category = categories[x]
+	var changed []string
+	for _, episodePath := range episodes {
+		if _, _, ok := inc.cached(episodePath); !ok {
+			changed = append(changed, episodePath)
+		}
+	}
+	parseFile := parse(season, changed)
+	reused := 0
+	for i, episodePath := range episodes {
+		if ctx.Err() != nil {
+			stopSeason(season, outPath, writePath, appending, inc, csvFile, flush)
+			slog.Warn("season interrupted", "season", season, "episodes", i, "of", len(episodes))
+			return
+		}
+		var episodeRows [][]string
+		if rows, failed, ok := inc.cached(episodePath); ok {
+			reused++
+			inc.written(episodePath)
+			if failed != nil {
+				prog.failure(season, *failed)
+				continue
+			}
+			episodeRows = rows
+		} else {
+			episodeRows, err = parseFile(episodePath)
+			if err != nil {
+				slog.Error("error parsing episode", "season", season,
+					"epNum", strings.TrimSuffix(filepath.Base(episodePath), ".html"), "file", episodePath, "err", err)
+				prog.episodeFailed(season, episodePath, err)
+				if inc != nil {
+					rec := newErrorRecord(season, episodePath, err)
+					inc.parsed(episodePath, 0, &rec)
+					inc.written(episodePath)
+				}
+				continue
 			}
-			roundName := "Jeopardy"
-			if round == 1 {
-				roundName = "Double Jeopardy"
+			if inc != nil {
+				inc.parsed(episodePath, len(episodeRows), nil)
+				inc.written(episodePath)
 			}
+		}
 
-			// Append row to CSV
-			row := []string{epNum, airDate, roundName, category, value, dailyDouble, question, answer}
-			rows = append(rows, row)
-
-			// Update column tracker (assuming 6 columns per round)
-			if x == 5 {
-				x = 0
-			} else {
-				x++
+		// Write rows to the CSV, unless they are already there, and get
+		// them onto disk before the next episode
+		if !appending || i >= inc.keep {
+			for _, row := range episodeRows {
+				writer.Write(row)
 			}
-		})
-	} else if round == 2 {
-		// Final Jeopardy
-		onmouseover, exists := table.Find("div[onmouseover]").Attr("onmouseover")
-		value := ""
-		if exists {
-			doc, err := goquery.NewDocumentFromReader(strings.NewReader(onmouseover))
-			if err == nil {
-				// Collect text from <td> elements that have no attributes
-				var vals []string
-				doc.Find("td").Each(func(i int, s *goquery.Selection) {
-					vals = append(vals, strings.TrimSpace(s.Text()))
-				})
-				value = strings.Join(vals, ",")
+			if !flush() {
+				return
 			}
 		}
-		question := strings.TrimSpace(table.Find("td#clue_FJ").Text())
-		answer := ""
-		responseSel := table.Find("td#clue_FJ_r")
-		if responseSel.Length() > 0 {
-			answer = strings.TrimSpace(responseSel.Find("em.correct_response").Text())
+		prog.episodeParsed(season, revealedClues(episodeRows, opts))
+	}
+	if !flush() {
+		return
+	}
+	if err := csvFile.Close(); err != nil {
+		slog.Error("error writing CSV file", "season", season, "file", writePath, "err", err)
+		return
+	}
+	if !appending {
+		if err := os.Rename(writePath, outPath); err != nil {
+			slog.Error("error replacing CSV file", "season", season, "file", outPath, "err", err)
+			return
 		}
+	}
+	if inc != nil {
+		// saved once the CSV is complete, as it records the CSV's size
+		inc.save(outPath)
+	}
+	prog.seasonDone(season)
+	stats := prog.stats(season)
+	slog.Info("season complete", "season", season, "episodes", stats.episodes,
+		"parsed", stats.parsed, "clues", stats.clues, "failed", stats.failed, "unchanged", reused)
+}
 
-		dailyDouble := "false"
-		category := strings.TrimSpace(table.Find("td.category_name").Text())
-		roundName := "Final Jeopardy"
-		row := []string{epNum, airDate, roundName, category, value, dailyDouble, question, answer}
-		rows = append(rows, row)
-	} else if round == 3 {
-		// Tiebreaker round
-		value := ""
-		question := strings.TrimSpace(table.Find("td#clue_TB").Text())
-		answer := ""
-		onmouseover, exists := table.Find("div[onmouseover]").Attr("onmouseover")
-		if exists {
-			doc, err := goquery.NewDocumentFromReader(strings.NewReader(onmouseover))
-			if err == nil {
-				answer = strings.TrimSpace(doc.Find("em").Text())
-			}
+// closes the CSV of a season whose run was cancelled after the episodes in
+// inc's order. An incremental run keeps them, renaming a partial file over
+// the CSV and saving the state, so the next run only has the rest to add;
+// otherwise the partial file is removed and the previous CSV stays.
+func stopSeason(season, outPath, writePath string, appending bool, inc *incremental, csvFile *os.File, flush func() bool) {
+	if !flush() {
+		return
+	}
+	if err := csvFile.Close(); err != nil {
+		slog.Error("error writing CSV file", "season", season, "file", writePath, "err", err)
+		return
+	}
+	if inc == nil {
+		os.Remove(writePath)
+		return
+	}
+	if !appending {
+		if err := os.Rename(writePath, outPath); err != nil {
+			slog.Error("error replacing CSV file", "season", season, "file", outPath, "err", err)
+			return
 		}
-		dailyDouble := "false"
-		category := strings.TrimSpace(table.Find("td.category_name").Text())
-		roundName := "Tiebreaker"
-		row := []string{epNum, airDate, roundName, category, value, dailyDouble, question, answer}
-		rows = append(rows, row)
 	}
+	inc.save(outPath)
+}
 
+// flattens a game into CSV rows, sorted by category then value, each
+// ending with the optional columns cols asks for; premiere is the air
+// date of the season's first episode
+func gameRows(season string, game *jarchive.Game, cols columns, premiere string) [][]string {
+	clues := game.Clues()
+	sortClues(clues)
+	tournament := tournamentFields(game.Tournament)
+	var rows [][]string
+	for _, clue := range clues {
+		value := ""
+		if clue.Value != 0 {
+			value = strconv.Itoa(clue.Value)
+		}
+		row := []string{jarchive.ClueID(game.GameID, season, game.EpisodeNumber, clue), season, game.GameID, game.EpisodeNumber, game.AirDate, clue.Round, cols.text(clue.Category),
+			value, clue.ValueRaw, strconv.FormatBool(clue.DailyDouble), boardPosition(clue.Column), boardPosition(clue.Row),
+			cols.text(clue.Question), cols.text(clue.Notes), cols.text(clue.Answer), jarchive.NormalizeAnswer(clue.Answer), strconv.FormatBool(clue.TripleStumper)}
+		row = append(row, tournament...)
+		row = append(row, game.Host, game.Format)
+		rows = append(rows, cols.fields(row, clue, game.AirDate, premiere))
+	}
 	return rows
 }
+
+// returns the tournament, tournament_stage and tournament_game columns,
+// empty for regular games
+func tournamentFields(t *jarchive.Tournament) []string {
+	if t == nil {
+		return []string{"", "", ""}
+	}
+	game := ""
+	if t.Game != 0 {
+		game = strconv.Itoa(t.Game)
+	}
+	return []string{t.Name, t.Stage, game}
+}
+
+// formats a board column or row, empty for clues that aren't on a board
+func boardPosition(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
+
+// sorts clues by category, then value with Daily Doubles after the regular
+// clues. The sort is stable so clues that compare equal keep their board
+// order and output is the same on every run.
+func sortClues(clues []jarchive.Clue) {
+	sort.SliceStable(clues, func(i, j int) bool {
+		a, b := clues[i], clues[j]
+		if a.Category != b.Category {
+			return a.Category < b.Category
+		}
+		if a.DailyDouble != b.DailyDouble {
+			return !a.DailyDouble
+		}
+		return a.Value < b.Value
+	})
+}
+
+// counts the rows that are actual clues rather than unrevealed placeholders
+func revealedClues(rows [][]string, opts Options) int {
+	if !opts.Unrevealed {
+		return len(rows)
+	}
+	n := 0
+	for _, row := range rows {
+		if row[len(row)-1] == "true" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,127 @@
+package parse
+
+import (
+	"os"
+	"sync"
+)
+
+// pipeline parses episode files in stages connected by bounded channels: a
+// reader goroutine loads each file, a pool of workers shared by every season
+// parses what it read, and each season's writer collects its results in
+// show number order. A stage that falls behind blocks the one feeding it,
+// so a slow disk or a slow writer holds back the rest instead of letting
+// work pile up in memory.
+type pipeline struct {
+	reads   chan pipelineJob
+	parses  chan readFile
+	workers sync.WaitGroup
+	// how far ahead of its writer a season queues episodes
+	window int
+}
+
+// pipelineJob is one file to read and then parse
+type pipelineJob struct {
+	file string
+	// runs on a parse worker with the file's contents, or the error
+	// reading it
+	parse func(body []byte, err error)
+}
+
+// readFile is a job whose file has been read
+type readFile struct {
+	job  pipelineJob
+	body []byte
+	err  error
+}
+
+// starts the reader and the given number of parse workers
+func newPipeline(workers int) *pipeline {
+	p := &pipeline{
+		reads:  make(chan pipelineJob, workers),
+		parses: make(chan readFile, workers),
+		window: 2 * workers,
+	}
+	go func() {
+		for job := range p.reads {
+			body, err := os.ReadFile(job.file)
+			p.parses <- readFile{job, body, err}
+		}
+		close(p.parses)
+	}()
+	for range workers {
+		p.workers.Add(1)
+		go func() {
+			defer p.workers.Done()
+			for f := range p.parses {
+				f.job.parse(f.body, f.err)
+			}
+		}()
+	}
+	return p
+}
+
+// stops the stages once the queued files are done
+func (p *pipeline) close() {
+	close(p.reads)
+	p.workers.Wait()
+}
+
+type pipelineResult[T any] struct {
+	value T
+	err   error
+}
+
+// inOrder sends a list of files through a pipeline, queueing at most the
+// pipeline's window of them ahead of the caller, and hands the results
+// back in the list's order. Only the results not yet collected are held in
+// memory.
+type inOrder[T any] struct {
+	pipeline *pipeline
+	files    []string
+	parse    func(file string, body []byte) (T, error)
+	results  []chan pipelineResult[T]
+	// files handed back so far
+	next int
+}
+
+// starts sending files through p, to be parsed from their contents with
+// parse
+func parseInOrder[T any](p *pipeline, files []string, parse func(file string, body []byte) (T, error)) *inOrder[T] {
+	o := &inOrder[T]{pipeline: p, files: files, parse: parse}
+	o.fill()
+	return o
+}
+
+// queues files until the window ahead of the next result is full
+func (o *inOrder[T]) fill() {
+	for len(o.results) < len(o.files) && len(o.results) < o.next+o.pipeline.window {
+		file := o.files[len(o.results)]
+		done := make(chan pipelineResult[T], 1)
+		o.results = append(o.results, done)
+		o.pipeline.reads <- pipelineJob{file: file, parse: func(body []byte, err error) {
+			var v T
+			if err == nil {
+				v, err = o.parse(file, body)
+			}
+			done <- pipelineResult[T]{v, err}
+		}}
+	}
+}
+
+// returns the result for file, which has to be the next one in the list;
+// files asked for out of order are read and parsed directly
+func (o *inOrder[T]) get(file string) (T, error) {
+	if o.next >= len(o.files) || o.files[o.next] != file {
+		body, err := os.ReadFile(file)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+		return o.parse(file, body)
+	}
+	res := <-o.results[o.next]
+	o.results[o.next] = nil
+	o.next++
+	o.fill()
+	return res.value, res.err
+}
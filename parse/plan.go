@@ -0,0 +1,139 @@
+package parse
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"j-parser-go/download"
+)
+
+// Plan is what Run would do, worked out without parsing or writing anything
+type Plan struct {
+	Seasons []SeasonPlan
+	// where the error report would go
+	OutDir string
+	// with LayoutNormalized, the tables that would be written
+	Tables []string
+	// with TargetPlayers, the player pages that would be read
+	Players int
+}
+
+// SeasonPlan is one season Run would parse
+type SeasonPlan struct {
+	Season string
+	// episode files in the season folder
+	Episodes int
+	// the CSV that would be written; empty with LayoutNormalized
+	CSV string
+	// with TargetSeasons, the cached season page that would be read
+	Page string
+}
+
+// lists the seasons Run would parse and the CSVs it would write
+func PlanRun(opts Options) (*Plan, error) {
+	opts.setDefaults()
+	switch opts.Target {
+	case TargetSeasons:
+		return planSeasonPages(opts)
+	case TargetScores:
+		return planScores(opts)
+	case TargetPlayers:
+		return planPlayers(opts)
+	}
+	if err := opts.checkLayout(); err != nil {
+		return nil, err
+	}
+	seasons, err := selectedSeasons(opts)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{OutDir: opts.OutDir}
+	normalized := opts.Layout == LayoutNormalized
+	if normalized {
+		for _, name := range normalizedFiles {
+			plan.Tables = append(plan.Tables, filepath.Join(opts.OutDir, name))
+		}
+	}
+	for _, season := range seasons {
+		entries, err := os.ReadDir(seasonPath(opts, season))
+		if err != nil {
+			return nil, fmt.Errorf("error reading season %s: %v", season, err)
+		}
+		sp := SeasonPlan{Season: season}
+		if !normalized {
+			sp.CSV = csvPath(opts, season)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".html" {
+				sp.Episodes++
+			}
+		}
+		plan.Seasons = append(plan.Seasons, sp)
+	}
+	return plan, nil
+}
+
+// returns the CSV Run and Syncer write for a season
+func CSVPath(opts Options, season string) string {
+	opts.setDefaults()
+	return csvPath(opts, season)
+}
+
+func csvPath(opts Options, season string) string {
+	return filepath.Join(opts.OutDir, fmt.Sprintf("j-archive-season-%s.csv", season))
+}
+
+// returns the seasons that have a CSV in opts.OutDir, numbered seasons
+// first in numeric order followed by named seasons alphabetically
+func ParsedSeasons(opts Options) ([]string, error) {
+	opts.setDefaults()
+	entries, err := os.ReadDir(opts.OutDir)
+	if err != nil {
+		return nil, err
+	}
+	var seasons []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if season, ok := strings.CutPrefix(strings.TrimSuffix(name, ".csv"), "j-archive-season-"); ok &&
+			!entry.IsDir() && strings.HasSuffix(name, ".csv") {
+			seasons = append(seasons, season)
+		}
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		return download.SeasonLess(seasons[i], seasons[j])
+	})
+	return seasons, nil
+}
+
+// prints a line per season and the totals
+func (p *Plan) Write(w io.Writer) {
+	total := 0
+	for _, s := range p.Seasons {
+		if s.Page != "" {
+			fmt.Fprintf(w, "season %s: %s\n", s.Season, s.Page)
+			continue
+		}
+		if s.CSV == "" {
+			fmt.Fprintf(w, "season %s: %d episodes\n", s.Season, s.Episodes)
+		} else {
+			fmt.Fprintf(w, "season %s: %d episodes -> %s\n", s.Season, s.Episodes, s.CSV)
+		}
+		total += s.Episodes
+	}
+	for _, table := range p.Tables {
+		fmt.Fprintf(w, "-> %s\n", table)
+	}
+	if p.Players > 0 {
+		fmt.Fprintf(w, "%d player pages\n", p.Players)
+		return
+	}
+	if len(p.Seasons) > 0 && p.Seasons[0].Page != "" {
+		fmt.Fprintf(w, "%d season pages\n", len(p.Seasons))
+		return
+	}
+	fmt.Fprintf(w, "%d episodes in %d seasons; error report in %s\n", total, len(p.Seasons), p.OutDir)
+}
@@ -0,0 +1,85 @@
+package parse
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"j-parser-go/download"
+	"j-parser-go/jarchive"
+)
+
+// file TargetPlayers writes to the output directory
+const playersFile = "players.csv"
+
+// first line of players.csv
+var playersHeader = []string{"player_id", "name", "appearances", "game_ids"}
+
+// runs TargetPlayers: parses the player pages download -players saved into
+// one row per player in players.csv. The player pages aren't kept by
+// season, so Seasons and SkipSeasons don't apply.
+func runPlayers(opts Options) (Result, error) {
+	res := Result{Started: time.Now()}
+	files, err := playerFiles(opts)
+	if err != nil {
+		return res, err
+	}
+	rows := [][]string{playersHeader}
+	for _, file := range files {
+		res.Episodes++
+		player, err := jarchive.ParsePlayerFile(file)
+		if err != nil {
+			slog.Error("error parsing player page", "file", file, "err", err)
+			res.Failed++
+			res.Errors = append(res.Errors, newErrorRecord("", file, err))
+			continue
+		}
+		// the file is named after the id it was downloaded by
+		id := strings.TrimSuffix(filepath.Base(file), ".html")
+		rows = append(rows, []string{id, player.Name, strconv.Itoa(len(player.GameIDs)), strings.Join(player.GameIDs, "; ")})
+		res.Parsed++
+	}
+
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return res, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
+	}
+	path := filepath.Join(opts.OutDir, playersFile)
+	if err := writeCSVFile(path, rows, opts.csvFormat()); err != nil {
+		return res, err
+	}
+	slog.Info("player pages parsed", "players", res.Parsed, "failed", res.Failed, "file", path)
+	if !opts.Quiet {
+		fmt.Printf("%d players written to %s", res.Parsed, path)
+		if res.Failed > 0 {
+			fmt.Printf("; %d player pages failed to parse", res.Failed)
+		}
+		fmt.Println()
+	}
+	return res, nil
+}
+
+// returns the saved player pages in player id order
+func playerFiles(opts Options) ([]string, error) {
+	files, err := filepath.Glob(download.PlayerFile(opts.ArchiveDir, "*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no player pages in %s; download them with download -players", filepath.Join(opts.ArchiveDir, download.PlayersDir))
+	}
+	sortEpisodes(files)
+	return files, nil
+}
+
+// PlanRun for TargetPlayers
+func planPlayers(opts Options) (*Plan, error) {
+	files, err := playerFiles(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &Plan{OutDir: opts.OutDir, Tables: []string{filepath.Join(opts.OutDir, playersFile)}, Players: len(files)}, nil
+}
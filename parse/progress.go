@@ -0,0 +1,162 @@
+package parse
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"j-parser-go/download"
+	"j-parser-go/internal/statusline"
+)
+
+// per-season parse totals
+type seasonStats struct {
+	episodes int
+	parsed   int
+	failed   int
+	clues    int
+	// set once the season's output is complete
+	done bool
+}
+
+// progress aggregates counts from all season goroutines and shows them on a
+// single status line, replacing the interleaved per-episode messages
+type progress struct {
+	mu      sync.Mutex
+	line    *statusline.Line
+	started time.Time
+	seasons map[string]*seasonStats
+	errors  []ErrorRecord
+}
+
+func newProgress(enabled bool) *progress {
+	p := &progress{started: time.Now(), seasons: make(map[string]*seasonStats)}
+	p.line = statusline.New(enabled, p.render)
+	return p
+}
+
+// registers a season and the number of episode files it contains
+func (p *progress) addSeason(season string, episodes int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seasons[season] = &seasonStats{episodes: episodes}
+}
+
+// records a successfully parsed episode and how many clues it produced
+func (p *progress) episodeParsed(season string, clues int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seasons[season].parsed++
+	p.seasons[season].clues += clues
+}
+
+// records an episode that couldn't be parsed and why
+func (p *progress) episodeFailed(season, file string, err error) {
+	p.failure(season, newErrorRecord(season, file, err))
+}
+
+// records a failed episode from its error record
+func (p *progress) failure(season string, rec ErrorRecord) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seasons[season].failed++
+	p.errors = append(p.errors, rec)
+}
+
+// records that a season's output is complete
+func (p *progress) seasonDone(season string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.seasons[season].done = true
+}
+
+// returns the seasons that haven't been completed, in the given order
+func (p *progress) unfinished(seasons []string) []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var left []string
+	for _, season := range seasons {
+		if s := p.seasons[season]; s == nil || !s.done {
+			left = append(left, season)
+		}
+	}
+	return left
+}
+
+// returns the totals over every season along with the collected errors
+func (p *progress) result() Result {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	res := Result{Started: p.started}
+	for season, s := range p.seasons {
+		res.Seasons = append(res.Seasons, season)
+		res.Episodes += s.episodes
+		res.Parsed += s.parsed
+		res.Failed += s.failed
+		res.Clues += s.clues
+	}
+	sort.Slice(res.Seasons, func(i, j int) bool { return download.SeasonLess(res.Seasons[i], res.Seasons[j]) })
+	res.Errors = append([]ErrorRecord(nil), p.errors...)
+	sortErrors(res.Errors)
+	return res
+}
+
+// returns a copy of the totals for one season
+func (p *progress) stats(season string) seasonStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return *p.seasons[season]
+}
+
+func (p *progress) finish() {
+	p.line.Finish()
+}
+
+func (p *progress) render() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var total seasonStats
+	for _, s := range p.seasons {
+		total.episodes += s.episodes
+		total.parsed += s.parsed
+		total.failed += s.failed
+		total.clues += s.clues
+	}
+	done := total.parsed + total.failed
+	percent := 0.0
+	if total.episodes > 0 {
+		percent = 100 * float64(done) / float64(total.episodes)
+	}
+	return fmt.Sprintf("Episodes %d/%d (%.0f%%) | %d clues | %d failures",
+		done, total.episodes, percent, total.clues, total.failed)
+}
+
+// writes a table of per-season totals, in season order, followed by a total row
+func (p *progress) writeSummary(w io.Writer) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	seasons := make([]string, 0, len(p.seasons))
+	for s := range p.seasons {
+		seasons = append(seasons, s)
+	}
+	sort.Slice(seasons, func(i, j int) bool {
+		return download.SeasonLess(seasons[i], seasons[j])
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "Season\tEpisodes\tParsed\tFailed\tClues\t")
+	var total seasonStats
+	for _, season := range seasons {
+		s := p.seasons[season]
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t\n", season, s.episodes, s.parsed, s.failed, s.clues)
+		total.episodes += s.episodes
+		total.parsed += s.parsed
+		total.failed += s.failed
+		total.clues += s.clues
+	}
+	fmt.Fprintf(tw, "Total\t%d\t%d\t%d\t%d\t\n", total.episodes, total.parsed, total.failed, total.clues)
+	tw.Flush()
+}
@@ -0,0 +1,117 @@
+package parse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"j-parser-go/download"
+	"j-parser-go/jarchive"
+)
+
+// names of the error report files written to the output directory
+const (
+	errorsJSONFile = "errors.json"
+	errorsCSVFile  = "errors.csv"
+)
+
+// ErrorRecord describes one episode that couldn't be parsed
+type ErrorRecord struct {
+	Season string `json:"season"`
+	EpNum  string `json:"epNum"`
+	File   string `json:"file"`
+	// round being parsed when it failed, empty for page-level failures
+	Round  string `json:"round,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// Result summarizes a parse run
+type Result struct {
+	// when the run began
+	Started time.Time
+	// the seasons parsed, in download.SeasonLess order
+	Seasons  []string
+	Episodes int
+	Parsed   int
+	Failed   int
+	Clues    int
+	// one record per failed episode, ordered by season then episode
+	Errors []ErrorRecord
+	// the seasons a cancelled run didn't finish, in download.SeasonLess order
+	Unfinished []string
+}
+
+// builds the record for a failed episode, pulling the round and cause out
+// of a *jarchive.ParseError when there is one
+func newErrorRecord(season, file string, err error) ErrorRecord {
+	rec := ErrorRecord{
+		Season: season,
+		EpNum:  strings.TrimSuffix(filepath.Base(file), ".html"),
+		File:   file,
+		Reason: err.Error(),
+	}
+	var pe *jarchive.ParseError
+	if errors.As(err, &pe) {
+		rec.Round = pe.Round
+		rec.Reason = pe.Err.Error()
+	}
+	return rec
+}
+
+// orders records by season then episode, the same order the CSVs use
+func sortErrors(records []ErrorRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].Season != records[j].Season {
+			return download.SeasonLess(records[i].Season, records[j].Season)
+		}
+		if records[i].EpNum != records[j].EpNum {
+			return download.SeasonLess(records[i].EpNum, records[j].EpNum)
+		}
+		return records[i].File < records[j].File
+	})
+}
+
+// writes errors.json and errors.csv to dir. When there are no errors any
+// report left over from an earlier run is removed instead, so a stale
+// report never outlives the problems it describes.
+func writeErrorReport(dir string, records []ErrorRecord, format csvFormat) error {
+	jsonPath := filepath.Join(dir, errorsJSONFile)
+	csvPath := filepath.Join(dir, errorsCSVFile)
+	if len(records) == 0 {
+		for _, path := range []string{jsonPath, csvPath} {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %v", jsonPath, err)
+	}
+	if err := os.WriteFile(jsonPath, data, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %v", jsonPath, err)
+	}
+
+	f, err := os.Create(csvPath)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %v", csvPath, err)
+	}
+	defer f.Close()
+	w := format.writer(f, true)
+	w.Write([]string{"season", "epNum", "file", "round", "reason"})
+	for _, r := range records {
+		w.Write([]string{r.Season, r.EpNum, r.File, r.Round, r.Reason})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("error writing %s: %v", csvPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package parse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+)
+
+// SchemaVersion numbers the column layout of the files parse writes. It is
+// bumped whenever a column is added, removed, renamed or changes meaning,
+// so consumers can tell from schema.json which layout they are reading.
+const SchemaVersion = 4
+
+// name of the schema manifest written to the output directory
+const schemaFile = "schema.json"
+
+// Schema is the manifest written next to the CSVs, describing their layout
+type Schema struct {
+	SchemaVersion int `json:"schemaVersion"`
+	// the program that wrote the files and its version, e.g.
+	// "j-parser-go v1.2.0"
+	Generator string `json:"generator"`
+	// LayoutFlat or LayoutNormalized
+	Layout string `json:"layout"`
+	// the columns of each file, keyed by file name; in the flat layout a
+	// single pattern such as "j-archive-season-*.csv" covers every season
+	Files map[string][]string `json:"files"`
+	// the Options.Newlines the text was written with; empty in manifests
+	// written before it was recorded, whose CSVs have no escaped line breaks
+	Newlines string `json:"newlines,omitempty"`
+}
+
+// reads the schema manifest from a parse output directory. It returns nil
+// and no error for directories written before there was one.
+func ReadSchema(dir string) (*Schema, error) {
+	data, err := os.ReadFile(filepath.Join(dir, schemaFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s Schema
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error reading %s: %v", filepath.Join(dir, schemaFile), err)
+	}
+	return &s, nil
+}
+
+// returns the schema of the files a run with opts writes
+func newSchema(opts Options) Schema {
+	s := Schema{SchemaVersion: SchemaVersion, Generator: generator(), Layout: opts.Layout, Files: make(map[string][]string), Newlines: opts.Newlines}
+	if opts.Layout == LayoutNormalized {
+		t := newTables(opts.columns())
+		for i, rows := range t.all() {
+			s.Files[normalizedFiles[i]] = rows[0]
+		}
+		return s
+	}
+	s.Files[filepath.Base(CSVPath(opts, "*"))] = opts.header()
+	return s
+}
+
+// writes schema.json for opts to the output directory
+func writeSchema(opts Options) error {
+	data, err := json.MarshalIndent(newSchema(opts), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(opts.OutDir, schemaFile), append(data, '\n'), 0o644)
+}
+
+// names the program writing the files and the module version it was built
+// as, which for builds from a checkout is a pseudo-version with the commit
+func generator() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "j-parser-go"
+	}
+	return info.Main.Path + " " + info.Main.Version
+}
@@ -0,0 +1,106 @@
+package parse
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"j-parser-go/download"
+	"j-parser-go/jarchive"
+)
+
+// file TargetScores writes to the output directory
+const scoresFile = "scores.csv"
+
+// first line of scores.csv
+var scoresHeader = []string{"season", "game_id", "epNum", "round_name", "clue_order", "player", "score"}
+
+// runs TargetScores: parses the scores pages download -scores saved in the
+// season folders into one row per player per clue in scores.csv
+func runScores(opts Options) (Result, error) {
+	res := Result{Started: time.Now()}
+	seasons, err := selectedSeasons(opts)
+	if err != nil {
+		return res, err
+	}
+	gameIDs := opts.episodeParser().gameIDs
+	rows := [][]string{scoresHeader}
+	for _, season := range seasons {
+		files, err := filepath.Glob(filepath.Join(seasonPath(opts, season), download.ScoresDir, "*.html"))
+		if err != nil {
+			return res, err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		sortEpisodes(files)
+		res.Seasons = append(res.Seasons, season)
+		for _, file := range files {
+			res.Episodes++
+			scores, err := jarchive.ParseScoresFile(file)
+			if err != nil {
+				slog.Error("error parsing scores", "season", season, "file", file, "err", err)
+				res.Failed++
+				res.Errors = append(res.Errors, newErrorRecord(season, file, err))
+				continue
+			}
+			epNum := strings.TrimSuffix(filepath.Base(file), ".html")
+			gameID := scores.GameID
+			if gameID == "" {
+				gameID = gameIDs[episodeKey{season, epNum}]
+			}
+			for _, round := range scores.Rounds {
+				for _, clue := range round.Clues {
+					for i, score := range clue.Scores {
+						rows = append(rows, []string{season, gameID, epNum, round.Name, strconv.Itoa(clue.Order),
+							scores.Players[i], strconv.Itoa(score)})
+					}
+				}
+			}
+			res.Parsed++
+		}
+	}
+	if res.Episodes == 0 {
+		return res, fmt.Errorf("no scores pages in %s; download them with download -scores", opts.ArchiveDir)
+	}
+
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return res, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
+	}
+	path := filepath.Join(opts.OutDir, scoresFile)
+	if err := writeCSVFile(path, rows, opts.csvFormat()); err != nil {
+		return res, err
+	}
+	slog.Info("scores parsed", "games", res.Parsed, "failed", res.Failed, "file", path)
+	if !opts.Quiet {
+		fmt.Printf("scores of %d games written to %s", res.Parsed, path)
+		if res.Failed > 0 {
+			fmt.Printf("; %d scores pages failed to parse", res.Failed)
+		}
+		fmt.Println()
+	}
+	return res, nil
+}
+
+// PlanRun for TargetScores
+func planScores(opts Options) (*Plan, error) {
+	seasons, err := selectedSeasons(opts)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{OutDir: opts.OutDir, Tables: []string{filepath.Join(opts.OutDir, scoresFile)}}
+	for _, season := range seasons {
+		files, err := filepath.Glob(filepath.Join(seasonPath(opts, season), download.ScoresDir, "*.html"))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) > 0 {
+			plan.Seasons = append(plan.Seasons, SeasonPlan{Season: season, Episodes: len(files)})
+		}
+	}
+	return plan, nil
+}
@@ -0,0 +1,115 @@
+package parse
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"j-parser-go/download"
+)
+
+// file TargetSeasons writes to the output directory
+const seasonsFile = "seasons.csv"
+
+// first line of seasons.csv
+var seasonsHeader = []string{"season", "game_id", "epNum", "airDate", "contestants", "description"}
+
+// runs TargetSeasons: reads the season pages cached in the archive and
+// writes every episode they list to seasons.csv, without reading a game
+func runSeasonPages(opts Options) (Result, error) {
+	res := Result{Started: time.Now()}
+	seasons, err := cachedSeasonPages(opts)
+	if err != nil {
+		return res, err
+	}
+	if len(seasons) == 0 {
+		return res, fmt.Errorf("no season pages in %s; download the seasons first", filepath.Dir(download.SeasonPageFile(opts.ArchiveDir, "")))
+	}
+	// cached pages don't record where they came from; relative links are
+	// what J! Archive uses, and these resolve the same against any site
+	page, _ := url.Parse(download.DefaultBaseURL + "/showseason.php")
+
+	rows := [][]string{seasonsHeader}
+	for _, season := range seasons {
+		file := download.SeasonPageFile(opts.ArchiveDir, season)
+		body, err := os.ReadFile(file)
+		if err != nil {
+			return res, fmt.Errorf("error reading season page %s: %v", file, err)
+		}
+		listings, err := download.ParseSeasonPage(bytes.NewReader(body), page)
+		if err != nil {
+			return res, fmt.Errorf("error reading season page %s: %v", file, err)
+		}
+		for _, l := range listings {
+			rows = append(rows, []string{season, l.GameID, l.Episode, l.AirDate, strings.Join(l.Contestants, "; "), l.Title})
+		}
+		slog.Debug("read season page", "season", season, "file", file, "episodes", len(listings))
+		res.Seasons = append(res.Seasons, season)
+		res.Episodes += len(listings)
+	}
+	res.Parsed = res.Episodes
+
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return res, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
+	}
+	path := filepath.Join(opts.OutDir, seasonsFile)
+	if err := writeCSVFile(path, rows, opts.csvFormat()); err != nil {
+		return res, err
+	}
+	slog.Info("season pages parsed", "seasons", len(res.Seasons), "episodes", res.Episodes, "file", path)
+	if !opts.Quiet {
+		fmt.Printf("%d episodes from %d season pages written to %s\n", res.Episodes, len(res.Seasons), path)
+	}
+	return res, nil
+}
+
+// PlanRun for TargetSeasons
+func planSeasonPages(opts Options) (*Plan, error) {
+	seasons, err := cachedSeasonPages(opts)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{OutDir: opts.OutDir, Tables: []string{filepath.Join(opts.OutDir, seasonsFile)}}
+	for _, season := range seasons {
+		plan.Seasons = append(plan.Seasons, SeasonPlan{Season: season, Page: download.SeasonPageFile(opts.ArchiveDir, season)})
+	}
+	return plan, nil
+}
+
+// returns the seasons with a cached season page, narrowed down by
+// opts.Seasons and opts.SkipSeasons, in download.SeasonLess order
+func cachedSeasonPages(opts Options) ([]string, error) {
+	pattern := download.SeasonPageFile(opts.ArchiveDir, "*")
+	files, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+	prefix, suffix, _ := strings.Cut(filepath.Base(pattern), "*")
+	want := make(map[string]bool)
+	for _, s := range opts.Seasons {
+		want[s] = true
+	}
+	skip := make(map[string]bool)
+	for _, s := range opts.SkipSeasons {
+		skip[s] = true
+	}
+	var seasons []string
+	for _, f := range files {
+		s := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(f), prefix), suffix)
+		if (len(want) == 0 || want[s]) && !skip[s] {
+			seasons = append(seasons, s)
+			delete(want, s)
+		}
+	}
+	for s := range want {
+		slog.Warn("no cached season page, skipping", "season", s, "file", download.SeasonPageFile(opts.ArchiveDir, s))
+	}
+	sort.Slice(seasons, func(i, j int) bool { return download.SeasonLess(seasons[i], seasons[j]) })
+	return seasons, nil
+}
@@ -0,0 +1,33 @@
+package parse
+
+import (
+	"context"
+	"log/slog"
+
+	"j-parser-go/internal/logging"
+	"j-parser-go/jarchive"
+)
+
+// parses one season's episodes like Games, but instead of collecting the
+// season calls fn with each game as soon as it and the episodes before it
+// are parsed, in show number order, so an embedding program can work on a
+// game while the rest of the season is still being parsed. Episodes that
+// fail are passed to fn with a nil game and their error, a
+// *jarchive.ParseError for pages that can't be parsed. An error returned
+// by fn stops the parse and is returned with the Result so far. OutDir
+// isn't used.
+func ParseSeasonStream(opts Options, season string, fn func(file string, game *jarchive.Game, err error) error) (Result, error) {
+	opts.setDefaults()
+	prog := newProgress(!opts.NoProgress)
+	if prog.line.Enabled() {
+		defer logging.Redirect(prog.line)()
+	}
+	parser := opts.episodeParser()
+	stages := newPipeline(opts.Concurrency)
+	err := streamSeasonGames(context.Background(), season, opts, prog, parser, stages, fn)
+	stages.close()
+	prog.finish()
+	res := prog.result()
+	slog.Info("parsing complete", "episodes", res.Episodes, "parsed", res.Parsed, "failed", res.Failed, "clues", res.Clues)
+	return res, err
+}
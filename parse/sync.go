@@ -0,0 +1,208 @@
+package parse
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"j-parser-go/download"
+)
+
+// Syncer parses episodes while they are still being downloaded. Each file
+// handed to Add is parsed straight away by a pool of workers; once
+// SeasonDone is called and that season's queued files are finished, the
+// season's CSV is rewritten, reusing the rows already parsed.
+//
+// A streaming Syncer never touches the archive directory: episodes arrive as
+// in-memory bodies through AddBody and each season's CSV holds exactly the
+// episodes streamed for it.
+type Syncer struct {
+	ctx       context.Context
+	opts      Options
+	parser    *episodeParser
+	prog      *progress
+	jobs      chan syncJob
+	streaming bool
+
+	mu      sync.Mutex
+	parsed  map[string]syncResult
+	pending map[string]*sync.WaitGroup
+	// episode keys streamed for each season, only used when streaming
+	streamed map[string][]string
+
+	workers sync.WaitGroup
+	writers sync.WaitGroup
+}
+
+type syncJob struct {
+	season string
+	file   string
+	// when set the episode is parsed from body and file is just its key
+	body []byte
+}
+
+type syncResult struct {
+	rows [][]string
+	err  error
+}
+
+// starts the parse workers. The progress display is always off because the
+// downloader owns the terminal during a sync.
+func NewSyncer(opts Options) (*Syncer, error) {
+	return NewSyncerContext(context.Background(), opts)
+}
+
+// like NewSyncer, but once ctx is done the seasons handed to SeasonDone
+// stop as they do in RunContext, and Close lists those it didn't write in
+// Result.Unfinished
+func NewSyncerContext(ctx context.Context, opts Options) (*Syncer, error) {
+	opts.setDefaults()
+	if err := os.MkdirAll(opts.OutDir, os.ModePerm); err != nil {
+		return nil, fmt.Errorf("error creating CSV folder %s: %v", opts.OutDir, err)
+	}
+	s := &Syncer{
+		ctx:      ctx,
+		opts:     opts,
+		parser:   opts.episodeParser(),
+		prog:     newProgress(false),
+		jobs:     make(chan syncJob, opts.Concurrency),
+		parsed:   make(map[string]syncResult),
+		pending:  make(map[string]*sync.WaitGroup),
+		streamed: make(map[string][]string),
+	}
+	for range opts.Concurrency {
+		s.workers.Add(1)
+		go s.work()
+	}
+	return s, nil
+}
+
+// starts a Syncer whose episodes are only ever handed over with AddBody
+func NewStreamingSyncer(opts Options) (*Syncer, error) {
+	return NewStreamingSyncerContext(context.Background(), opts)
+}
+
+// like NewStreamingSyncer, stopping once ctx is done as NewSyncerContext
+// does
+func NewStreamingSyncerContext(ctx context.Context, opts Options) (*Syncer, error) {
+	s, err := NewSyncerContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.streaming = true
+	// streamed episodes have no files to compare with the last run
+	s.opts.Incremental = false
+	return s, nil
+}
+
+func (s *Syncer) work() {
+	defer s.workers.Done()
+	for job := range s.jobs {
+		var rows [][]string
+		var err error
+		if job.body != nil {
+			rows, err = s.parser.rowsFrom(job.season, bytes.NewReader(job.body), job.file)
+		} else {
+			rows, err = s.parser.rows(job.season, job.file)
+		}
+		s.mu.Lock()
+		s.parsed[job.file] = syncResult{rows: rows, err: err}
+		wg := s.pending[job.season]
+		s.mu.Unlock()
+		wg.Done()
+	}
+}
+
+// queues a newly downloaded episode file for parsing
+func (s *Syncer) Add(season, file string) {
+	s.seasonGroup(season).Add(1)
+	s.jobs <- syncJob{season: season, file: file}
+}
+
+// queues the HTML of an episode that was downloaded but not saved
+func (s *Syncer) AddBody(season, episode string, body []byte) {
+	key := filepath.Join(seasonPath(s.opts, season), episode+".html")
+	s.mu.Lock()
+	s.streamed[season] = append(s.streamed[season], key)
+	s.mu.Unlock()
+	s.seasonGroup(season).Add(1)
+	s.jobs <- syncJob{season: season, file: key, body: body}
+}
+
+// writes the season's CSV once every episode queued for it has been parsed
+func (s *Syncer) SeasonDone(season string) {
+	wg := s.seasonGroup(season)
+	s.writers.Add(1)
+	go func() {
+		defer s.writers.Done()
+		wg.Wait()
+		if !s.streaming {
+			parseSeason(s.ctx, season, s.opts, s.prog, s.parseFiles)
+			return
+		}
+		s.mu.Lock()
+		episodes := s.streamed[season]
+		delete(s.streamed, season)
+		s.mu.Unlock()
+		if len(episodes) == 0 {
+			slog.Warn("no episodes streamed, not writing CSV", "season", season)
+			return
+		}
+		sortEpisodes(episodes)
+		writeSeason(s.ctx, season, s.opts, s.prog, episodes, s.parseFiles)
+	}()
+}
+
+// waits for all outstanding seasons to be written, prints the summary and
+// writes the error report. Once the Syncer's context is done it prints the
+// summary of a cancelled run instead, see RunContext.
+func (s *Syncer) Close() Result {
+	s.writers.Wait()
+	close(s.jobs)
+	s.workers.Wait()
+	if s.ctx.Err() != nil {
+		s.mu.Lock()
+		var seasons []string
+		for season := range s.pending {
+			seasons = append(seasons, season)
+		}
+		s.mu.Unlock()
+		sort.Slice(seasons, func(i, j int) bool { return download.SeasonLess(seasons[i], seasons[j]) })
+		return cancelRun(s.opts, s.prog, s.prog.unfinished(seasons))
+	}
+	return finishRun(s.opts, s.prog)
+}
+
+func (s *Syncer) seasonGroup(season string) *sync.WaitGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	wg, ok := s.pending[season]
+	if !ok {
+		wg = &sync.WaitGroup{}
+		s.pending[season] = wg
+	}
+	return wg
+}
+
+// a seasonParser handing back the rows of episodes the workers have parsed
+func (s *Syncer) parseFiles(season string, _ []string) func(string) ([][]string, error) {
+	return func(file string) ([][]string, error) { return s.parseFile(season, file) }
+}
+
+// returns the rows parsed by a worker, falling back to parsing files that
+// were already on disk before this run
+func (s *Syncer) parseFile(season, file string) ([][]string, error) {
+	s.mu.Lock()
+	res, ok := s.parsed[file]
+	delete(s.parsed, file)
+	s.mu.Unlock()
+	if ok {
+		return res.rows, res.err
+	}
+	return s.parser.rows(season, file)
+}
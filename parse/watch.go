@@ -0,0 +1,120 @@
+package parse
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"j-parser-go/download"
+)
+
+// how long the archive has to be quiet before Watch parses what changed,
+// so that a season being downloaded is parsed in batches rather than once
+// per file
+const watchSettle = 2 * time.Second
+
+// Watch parses the archive like Run, then watches the archive directory
+// for episode pages being added or changed and parses their seasons again,
+// incrementally, until ctx is done. done is called with the outcome of
+// every run, the first included. The normalized layout is parsed in full
+// each time.
+func Watch(ctx context.Context, opts Options, done func(Result, error)) error {
+	opts.setDefaults()
+	opts.Incremental = opts.Layout == LayoutFlat
+	if err := opts.checkLayout(); err != nil {
+		return err
+	}
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if err := w.Add(opts.ArchiveDir); err != nil {
+		return fmt.Errorf("error watching %s: %v", opts.ArchiveDir, err)
+	}
+	seasons, err := getAllSeasons(opts.ArchiveDir)
+	if err != nil {
+		return fmt.Errorf("error getting seasons: %v", err)
+	}
+	for _, season := range seasons {
+		if err := w.Add(seasonPath(opts, season)); err != nil {
+			return fmt.Errorf("error watching %s: %v", seasonPath(opts, season), err)
+		}
+	}
+
+	done(RunContext(ctx, opts))
+	slog.Info("watching for new episodes", "dir", opts.ArchiveDir)
+	changed := make(map[string]bool)
+	settle := time.NewTimer(watchSettle)
+	settle.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-w.Errors:
+			slog.Error("error watching archive", "dir", opts.ArchiveDir, "err", err)
+		case ev := <-w.Events:
+			season, ok := watchedSeason(opts, w, ev)
+			if !ok || !opts.wantsSeason(season) {
+				continue
+			}
+			slog.Debug("archive changed", "season", season, "file", ev.Name, "op", ev.Op.String())
+			changed[season] = true
+			settle.Reset(watchSettle)
+		case <-settle.C:
+			runOpts := opts
+			// the normalized tables always hold every season
+			if opts.Layout == LayoutFlat {
+				runOpts.Seasons, runOpts.SkipSeasons = nil, nil
+				for season := range changed {
+					runOpts.Seasons = append(runOpts.Seasons, season)
+				}
+				sort.Slice(runOpts.Seasons, func(i, j int) bool {
+					return download.SeasonLess(runOpts.Seasons[i], runOpts.Seasons[j])
+				})
+			}
+			clear(changed)
+			slog.Info("parsing changed seasons", "seasons", runOpts.Seasons)
+			done(RunContext(ctx, runOpts))
+		}
+	}
+}
+
+// returns the season an event is about, watching season folders as they
+// are created; ok is false for events that don't concern an episode page
+func watchedSeason(opts Options, w *fsnotify.Watcher, ev fsnotify.Event) (season string, ok bool) {
+	if !ev.Has(fsnotify.Create) && !ev.Has(fsnotify.Write) {
+		return "", false
+	}
+	dir, name := filepath.Split(ev.Name)
+	if filepath.Clean(dir) == filepath.Clean(opts.ArchiveDir) {
+		season, ok := download.SeasonOfDir(name)
+		if !ok || !ev.Has(fsnotify.Create) {
+			return "", false
+		}
+		if err := w.Add(ev.Name); err != nil {
+			slog.Error("error watching season folder", "dir", ev.Name, "err", err)
+		}
+		// its episodes may be there already, e.g. if it was moved in
+		return season, true
+	}
+	season, ok = download.SeasonOfDir(filepath.Base(dir))
+	if !ok || filepath.Ext(name) != ".html" {
+		return "", false
+	}
+	return season, true
+}
+
+// reports whether opts.Seasons and opts.SkipSeasons select the season
+func (o *Options) wantsSeason(season string) bool {
+	if len(o.Seasons) > 0 && !slices.Contains(o.Seasons, season) {
+		return false
+	}
+	return !slices.Contains(o.SkipSeasons, season)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// starts the CPU profile asked for with -cpuprofile, and returns the
+// function that stops it and writes the -memprofile heap profile once the
+// command is done
+func (c *commonFlags) startProfiles() (stop func() error, err error) {
+	var cpu *os.File
+	if c.cpuProfile != "" {
+		if cpu, err = os.Create(c.cpuProfile); err != nil {
+			return nil, fmt.Errorf("error creating CPU profile: %v", err)
+		}
+		if err := pprof.StartCPUProfile(cpu); err != nil {
+			cpu.Close()
+			return nil, fmt.Errorf("error starting CPU profile: %v", err)
+		}
+	}
+	return func() error {
+		var errs []error
+		if cpu != nil {
+			pprof.StopCPUProfile()
+			if err := cpu.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("error writing CPU profile: %v", err))
+			}
+		}
+		if c.memProfile != "" {
+			errs = append(errs, writeHeapProfile(c.memProfile))
+		}
+		return errors.Join(errs...)
+	}, nil
+}
+
+// writes a heap profile of what is still in use and everything allocated
+// since the start, after a GC so the numbers are up to date
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating memory profile: %v", err)
+	}
+	runtime.GC()
+	if err := errors.Join(pprof.WriteHeapProfile(f), f.Close()); err != nil {
+		return fmt.Errorf("error writing memory profile: %v", err)
+	}
+	return nil
+}
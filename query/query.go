@@ -0,0 +1,223 @@
+// Package query runs SQL over the parsed clues, loaded into an in-memory
+// SQLite database, for ad-hoc analysis without setting up a database.
+package query
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	_ "modernc.org/sqlite"
+
+	"j-parser-go/dataset"
+)
+
+// the clues table's columns that aren't text; the integers the CSVs leave
+// empty, such as Final Jeopardy's value and board position, are NULL
+var (
+	integerColumns = map[string]bool{"value": true, "board_column": true, "board_row": true, "tournament_game": true}
+	booleanColumns = map[string]bool{"daily_double": true, "triple_stumper": true, "revealed": true}
+)
+
+// the columns of the clues table: the parse CSV's, then revealed
+var clueColumns = append(dataset.Header[:len(dataset.Header):len(dataset.Header)], "revealed")
+
+// a view of each game once, for queries about games rather than clues
+const gamesView = `CREATE VIEW games AS
+	SELECT season, game_id, epNum, airDate, tournament, tournament_stage, tournament_game, host, game_format, count(*) AS clues
+	FROM clues GROUP BY season, epNum`
+
+// DB is the clues loaded into SQLite
+type DB struct {
+	db *sql.DB
+}
+
+// loads clues into a new in-memory database, as a clues table with one
+// column per parse CSV column plus revealed, typed (booleans as 0 and 1),
+// and a games view with a row per game
+func Open(clues []dataset.Clue) (*DB, error) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		return nil, err
+	}
+	// every connection to :memory: is a database of its own
+	db.SetMaxOpenConns(1)
+	if err := load(db, clues); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error loading clues: %v", err)
+	}
+	return &DB{db: db}, nil
+}
+
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// creates the clues table and view and inserts clues in one transaction
+func load(db *sql.DB, clues []dataset.Clue) error {
+	defs := make([]string, len(clueColumns))
+	for i, name := range clueColumns {
+		typ := "TEXT"
+		if integerColumns[name] || booleanColumns[name] {
+			typ = "INTEGER"
+		}
+		defs[i] = name + " " + typ
+	}
+	if _, err := db.Exec(`CREATE TABLE clues (` + strings.Join(defs, ", ") + `)`); err != nil {
+		return err
+	}
+	if _, err := db.Exec(gamesView); err != nil {
+		return err
+	}
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	insert, err := tx.Prepare(`INSERT INTO clues VALUES (` + strings.TrimSuffix(strings.Repeat("?, ", len(clueColumns)), ", ") + `)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	args := make([]any, len(clueColumns))
+	for i := range clues {
+		for j, field := range append(clues[i].Record(), strconv.FormatBool(clues[i].Revealed)) {
+			args[j] = sqlValue(clueColumns[j], field)
+		}
+		if _, err := insert.Exec(args...); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// converts a CSV field to the type of its column
+func sqlValue(column, field string) any {
+	switch {
+	case integerColumns[column]:
+		if n, err := strconv.Atoi(field); err == nil {
+			return n
+		}
+		return nil
+	case booleanColumns[column]:
+		return field == "true"
+	}
+	return field
+}
+
+// Result is what a query returned
+type Result struct {
+	Columns []string
+	// each row's values in Columns' order: int64, float64, string, []byte or
+	// nil for NULL
+	Rows [][]any
+}
+
+// runs a query, such as a SELECT, and returns all its rows
+func (d *DB) Query(stmt string) (*Result, error) {
+	rows, err := d.db.Query(stmt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	res := &Result{}
+	if res.Columns, err = rows.Columns(); err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		row := make([]any, len(res.Columns))
+		ptrs := make([]any, len(row))
+		for i := range row {
+			ptrs[i] = &row[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	return res, rows.Err()
+}
+
+// formats a value for text and CSV output, with NULL as an empty string
+func field(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// writes the result as aligned columns under a header, then a row count
+func (r *Result) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(r.Columns, "\t"))
+	for _, row := range r.Rows {
+		fields := make([]string, len(row))
+		for i, v := range row {
+			// keep multi-line clues on their row
+			fields[i] = strings.ReplaceAll(field(v), "\n", " ")
+		}
+		fmt.Fprintln(tw, strings.Join(fields, "\t"))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d rows\n", len(r.Rows))
+	return err
+}
+
+// writes the result as CSV, the column names first
+func (r *Result) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	cw.Write(r.Columns)
+	record := make([]string, len(r.Columns))
+	for _, row := range r.Rows {
+		for i, v := range row {
+			record[i] = field(v)
+		}
+		cw.Write(record)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes the result as a JSON array of objects keyed by column name, in
+// column order
+func (r *Result) WriteJSON(w io.Writer) error {
+	rows := make([]json.RawMessage, 0, len(r.Rows))
+	for _, row := range r.Rows {
+		var b strings.Builder
+		b.WriteByte('{')
+		for i, v := range row {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			name, _ := json.Marshal(r.Columns[i])
+			if s, ok := v.([]byte); ok {
+				v = string(s)
+			}
+			value, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			b.Write(name)
+			b.WriteByte(':')
+			b.Write(value)
+		}
+		b.WriteByte('}')
+		rows = append(rows, json.RawMessage(b.String()))
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(rows)
+}
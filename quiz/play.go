@@ -0,0 +1,194 @@
+// Package quiz plays clues from the parsed archive in the terminal: it
+// shows each clue, reads a typed response, checks it against the correct
+// response and keeps score the way the show does.
+package quiz
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// typed instead of a response to end the game early
+const quitCommand = "/quit"
+
+// Result is how a game went
+type Result struct {
+	// clues answered or passed, and how they went
+	Clues   int
+	Correct int
+	Wrong   int
+	Passed  int
+	Score   int
+}
+
+// session is one game in progress
+type session struct {
+	in  *bufio.Scanner
+	out io.Writer
+	res Result
+}
+
+// plays clues in order, reading responses from in and writing prompts to
+// out, until the clues run out, in is exhausted or the player types /quit
+func Play(clues []dataset.Clue, in io.Reader, out io.Writer) (Result, error) {
+	s := &session{in: bufio.NewScanner(in), out: out}
+	fmt.Fprintf(out, "%d clues. Type your response and press Enter; an empty line passes, %s ends the game.\n", len(clues), quitCommand)
+	for i := range clues {
+		more, err := s.play(&clues[i])
+		if err != nil {
+			return s.res, err
+		}
+		if !more {
+			break
+		}
+	}
+	fmt.Fprintf(out, "\nFinal score: %s (%d right, %d wrong, %d passed)\n", dollars(s.res.Score), s.res.Correct, s.res.Wrong, s.res.Passed)
+	return s.res, nil
+}
+
+// plays one clue; false means the player quit or input ran out
+func (s *session) play(c *dataset.Clue) (bool, error) {
+	fmt.Fprintf(s.out, "\n%s - %s", c.Round, c.Category)
+	amount := c.Value
+	switch {
+	case c.DailyDouble:
+		fmt.Fprintln(s.out, " - DAILY DOUBLE!")
+		wager, ok := s.wager(5, max(s.res.Score, roundMax(c)))
+		if !ok {
+			return false, s.in.Err()
+		}
+		amount = wager
+	case c.Round == jarchive.RoundFinalJeopardy:
+		fmt.Fprintln(s.out)
+		wager, ok := s.wager(0, max(s.res.Score, 0))
+		if !ok {
+			return false, s.in.Err()
+		}
+		amount = wager
+	case amount > 0:
+		fmt.Fprintf(s.out, " for %s\n", dollars(amount))
+	default:
+		fmt.Fprintln(s.out)
+	}
+
+	fmt.Fprintf(s.out, "  %s\n> ", c.Question)
+	response, ok := s.line()
+	if !ok || response == quitCommand {
+		return false, s.in.Err()
+	}
+	s.res.Clues++
+
+	wagered := c.DailyDouble || c.Round == jarchive.RoundFinalJeopardy
+	right := false
+	switch {
+	case response == "" && !wagered:
+		s.res.Passed++
+		fmt.Fprintf(s.out, "Correct response: %s\n", c.Answer)
+		return true, nil
+	case response == "":
+		// passing on a wager loses it
+		fmt.Fprintf(s.out, "The correct response is: %s\n", c.Answer)
+	case jarchive.MatchesAnswer(response, c.Answer):
+		right = true
+		fmt.Fprintf(s.out, "Correct! (%s)\n", c.Answer)
+	default:
+		fmt.Fprintf(s.out, "The correct response is: %s\nCount yours as right anyway? [y/N] ", c.Answer)
+		override, ok := s.line()
+		if !ok {
+			return false, s.in.Err()
+		}
+		right = strings.EqualFold(override, "y") || strings.EqualFold(override, "yes")
+	}
+	if right {
+		s.res.Correct++
+		s.res.Score += amount
+	} else {
+		s.res.Wrong++
+		s.res.Score -= amount
+	}
+	fmt.Fprintf(s.out, "Score: %s\n", dollars(s.res.Score))
+	return true, nil
+}
+
+// asks for a wager between lo and hi until it gets one
+func (s *session) wager(lo, hi int) (int, bool) {
+	for {
+		fmt.Fprintf(s.out, "Your score is %s. Wager (%d-%d): ", dollars(s.res.Score), lo, hi)
+		text, ok := s.line()
+		if !ok || text == quitCommand {
+			return 0, false
+		}
+		n, err := strconv.Atoi(strings.TrimPrefix(strings.ReplaceAll(text, ",", ""), "$"))
+		if err == nil && n >= lo && n <= hi {
+			return n, true
+		}
+		fmt.Fprintln(s.out, "That's not a valid wager.")
+	}
+}
+
+// reads a trimmed line of input; false at the end of input
+func (s *session) line() (string, bool) {
+	if !s.in.Scan() {
+		return "", false
+	}
+	return strings.TrimSpace(s.in.Text()), true
+}
+
+// the most a contestant with little or no money may wager on a Daily
+// Double: the top value of the round's board
+func roundMax(c *dataset.Clue) int {
+	top := 1000
+	// board values doubled on 2001-11-26
+	if c.AirDate != "" && c.AirDate < "2001-11-26" {
+		top = 500
+	}
+	switch c.Round {
+	case jarchive.RoundDoubleJeopardy:
+		top *= 2
+	case jarchive.RoundTripleJeopardy:
+		top *= 3
+	}
+	return top
+}
+
+// formats n as a score, e.g. -$1,200
+func dollars(n int) string {
+	sign := ""
+	if n < 0 {
+		sign, n = "-", -n
+	}
+	s := strconv.Itoa(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + "$" + s
+}
+
+// sorts one game's clues into the order they're played here: round by
+// round, and on the boards one category at a time from the top
+func GameOrder(clues []dataset.Clue) {
+	rank := map[string]int{
+		jarchive.RoundJeopardy:       0,
+		jarchive.RoundDoubleJeopardy: 1,
+		jarchive.RoundTripleJeopardy: 2,
+		jarchive.RoundFinalJeopardy:  3,
+		jarchive.RoundTiebreaker:     4,
+	}
+	sort.SliceStable(clues, func(i, j int) bool {
+		a, b := clues[i], clues[j]
+		if a.Round != b.Round {
+			return rank[a.Round] < rank[b.Round]
+		}
+		if a.Column != b.Column {
+			return a.Column < b.Column
+		}
+		return a.Row < b.Row
+	})
+}
@@ -0,0 +1,94 @@
+package search
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+
+	"j-parser-go/dataset"
+)
+
+// what Sample can stratify by
+const (
+	StratumRound  = "round"
+	StratumValue  = "value"
+	StratumSeason = "season"
+)
+
+// the key of each stratum
+var strata = map[string]func(c *dataset.Clue) string{
+	StratumRound:  func(c *dataset.Clue) string { return c.Round },
+	StratumValue:  func(c *dataset.Clue) string { return strconv.Itoa(c.Value) },
+	StratumSeason: func(c *dataset.Clue) string { return c.Season },
+}
+
+// returns n clues matching q picked at random using rng, in the order they
+// came in, or every match if fewer match; q.Limit is ignored. With strata
+// the matches are grouped by their round, value and/or season, and each
+// group gets its share of n in proportion to its size, so a small group
+// such as Final Jeopardy is in the sample about as often as in the data.
+// The same clues, rng seed and strata always give the same sample.
+func Sample(clues []dataset.Clue, q Query, n int, by []string, rng *rand.Rand) ([]dataset.Clue, error) {
+	for _, s := range by {
+		if strata[s] == nil {
+			return nil, fmt.Errorf("unknown stratum %q (want %s, %s or %s)", s, StratumRound, StratumValue, StratumSeason)
+		}
+	}
+	q.Limit = 0
+	matches := Search(clues, q)
+	n = min(n, len(matches))
+
+	// the indexes of the matches in each group, groups in the order first
+	// seen so the picks don't depend on map order
+	var keys []string
+	groups := make(map[string][]int)
+	for i := range matches {
+		parts := make([]string, len(by))
+		for j, s := range by {
+			parts[j] = strata[s](&matches[i])
+		}
+		key := strings.Join(parts, "\x00")
+		if groups[key] == nil {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	var picked []int
+	for i, share := range shares(keys, groups, len(matches), n) {
+		group := groups[keys[i]]
+		for _, j := range rng.Perm(len(group))[:share] {
+			picked = append(picked, group[j])
+		}
+	}
+	slices.Sort(picked)
+	sample := make([]dataset.Clue, len(picked))
+	for i, j := range picked {
+		sample[i] = matches[j]
+	}
+	return sample, nil
+}
+
+// splits n between the groups in proportion to their sizes, giving what
+// rounding down leaves over to the groups with the largest remainders
+func shares(keys []string, groups map[string][]int, total, n int) []int {
+	out := make([]int, len(keys))
+	if total == 0 {
+		return out
+	}
+	left := n
+	order := make([]int, len(keys))
+	for i, key := range keys {
+		out[i] = len(groups[key]) * n / total
+		left -= out[i]
+		order[i] = i
+	}
+	remainder := func(i int) int { return len(groups[keys[i]]) * n % total }
+	slices.SortStableFunc(order, func(a, b int) int { return remainder(b) - remainder(a) })
+	for _, i := range order[:left] {
+		out[i]++
+	}
+	return out
+}
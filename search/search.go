@@ -0,0 +1,272 @@
+// Package search finds clues in the parsed archive by their text, with
+// filters on season, round and value.
+package search
+
+import (
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// which text a query is matched against
+const (
+	FieldQuestion = "question"
+	FieldAnswer   = "answer"
+	FieldCategory = "category"
+)
+
+// Query is what to look for. Zero values don't filter anything.
+type Query struct {
+	// words that must all appear, ignoring case, in the searched fields;
+	// each word may be found in a different field
+	Text string
+	// fields to search, every field if empty
+	Fields []string
+	// only clues from these seasons
+	Seasons []string
+	// only clues from the category with this name, ignoring case
+	Category string
+	// only clues whose category matches this regular expression
+	CategoryRegex string
+	// only clues from these rounds, as named in jarchive's Round constants
+	Rounds []string
+	// only clues worth at least / at most this many dollars; clues without a
+	// value, such as Final Jeopardy, never match a bound
+	MinValue, MaxValue int
+	// only clues aired on or after / on or before this date, YYYY-MM-DD;
+	// clues without an air date never match a bound
+	After, Before string
+	// only Daily Doubles, only triple stumpers
+	DailyDouble, TripleStumper bool
+	// stop after this many matches, no limit if zero
+	Limit int
+}
+
+// round names accepted by ParseRound, lowercased
+var roundNames = map[string]string{
+	"j":               jarchive.RoundJeopardy,
+	"jeopardy":        jarchive.RoundJeopardy,
+	"dj":              jarchive.RoundDoubleJeopardy,
+	"double jeopardy": jarchive.RoundDoubleJeopardy,
+	"tj":              jarchive.RoundTripleJeopardy,
+	"triple jeopardy": jarchive.RoundTripleJeopardy,
+	"fj":              jarchive.RoundFinalJeopardy,
+	"final jeopardy":  jarchive.RoundFinalJeopardy,
+	"tb":              jarchive.RoundTiebreaker,
+	"tiebreaker":      jarchive.RoundTiebreaker,
+}
+
+// turns a round given on the command line, either its full name or J, DJ,
+// TJ, FJ or TB in any case, into the name used in the CSVs
+func ParseRound(s string) (string, error) {
+	if round, ok := roundNames[strings.ToLower(strings.TrimSpace(s))]; ok {
+		return round, nil
+	}
+	return "", fmt.Errorf("unknown round %q (want J, DJ, TJ, FJ or TB)", s)
+}
+
+// checks the query's fields and rounds
+func (q *Query) Validate() error {
+	for _, f := range q.Fields {
+		switch f {
+		case FieldQuestion, FieldAnswer, FieldCategory:
+		default:
+			return fmt.Errorf("unknown field %q (want question, answer or category)", f)
+		}
+	}
+	for _, r := range q.Rounds {
+		if _, err := ParseRound(r); err != nil {
+			return err
+		}
+	}
+	if q.CategoryRegex != "" {
+		if _, err := regexp.Compile(q.CategoryRegex); err != nil {
+			return fmt.Errorf("invalid category pattern: %v", err)
+		}
+	}
+	if q.MinValue > 0 && q.MaxValue > 0 && q.MinValue > q.MaxValue {
+		return fmt.Errorf("minimum value %d is above maximum value %d", q.MinValue, q.MaxValue)
+	}
+	for _, date := range []string{q.After, q.Before} {
+		if _, err := time.Parse(time.DateOnly, date); date != "" && err != nil {
+			return fmt.Errorf("invalid date %q (want YYYY-MM-DD)", date)
+		}
+	}
+	if q.After != "" && q.Before != "" && q.After > q.Before {
+		return fmt.Errorf("date %s is after %s", q.After, q.Before)
+	}
+	return nil
+}
+
+// parses a value filter: "1600" for exactly that value, ">=1600" or
+// "<=800" for a bound (">" and "<" work the same), or "800-1600" for a
+// range. Zeros in the result mean no bound.
+func ParseValueRange(s string) (lo, hi int, err error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), "$", "")
+	num := func(s string) (int, error) {
+		n, err := strconv.Atoi(strings.ReplaceAll(strings.TrimSpace(s), ",", ""))
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("%q is not a dollar value", s)
+		}
+		return n, nil
+	}
+	switch {
+	case strings.HasPrefix(s, ">="), strings.HasPrefix(s, ">"):
+		lo, err = num(strings.TrimLeft(s, ">="))
+	case strings.HasPrefix(s, "<="), strings.HasPrefix(s, "<"):
+		hi, err = num(strings.TrimLeft(s, "<="))
+	case strings.Contains(s, "-"):
+		from, to, _ := strings.Cut(s, "-")
+		if lo, err = num(from); err == nil {
+			hi, err = num(to)
+		}
+	default:
+		lo, err = num(s)
+		hi = lo
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	return lo, hi, nil
+}
+
+// returns the clues matching q, in the order given
+func Search(clues []dataset.Clue, q Query) []dataset.Clue {
+	m := newMatcher(q)
+	var matches []dataset.Clue
+	for i := range clues {
+		if m.match(&clues[i]) {
+			matches = append(matches, clues[i])
+			if q.Limit > 0 && len(matches) == q.Limit {
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// returns n clues matching q picked at random using rng, or all of them in
+// random order if fewer match. q.Limit is ignored.
+func Random(clues []dataset.Clue, q Query, n int, rng *rand.Rand) []dataset.Clue {
+	q.Limit = 0
+	matches := Search(clues, q)
+	n = min(n, len(matches))
+	picked := make([]dataset.Clue, 0, n)
+	for _, i := range rng.Perm(len(matches))[:n] {
+		picked = append(picked, matches[i])
+	}
+	return picked
+}
+
+// matcher is a Query prepared for matching many clues
+type matcher struct {
+	q       Query
+	words   []string
+	fields  map[string]bool
+	rounds  map[string]bool
+	seasons map[string]bool
+	// nil unless the query has a valid CategoryRegex
+	category *regexp.Regexp
+}
+
+func newMatcher(q Query) *matcher {
+	m := &matcher{q: q, words: strings.Fields(strings.ToLower(q.Text))}
+	if len(q.Fields) > 0 {
+		m.fields = make(map[string]bool)
+		for _, f := range q.Fields {
+			m.fields[f] = true
+		}
+	}
+	if q.CategoryRegex != "" {
+		m.category, _ = regexp.Compile(q.CategoryRegex)
+	}
+	if len(q.Seasons) > 0 {
+		m.seasons = make(map[string]bool)
+		for _, s := range q.Seasons {
+			m.seasons[s] = true
+		}
+	}
+	if len(q.Rounds) > 0 {
+		m.rounds = make(map[string]bool)
+		for _, r := range q.Rounds {
+			round, _ := ParseRound(r)
+			m.rounds[round] = true
+		}
+	}
+	return m
+}
+
+func (m *matcher) match(c *dataset.Clue) bool {
+	if !c.Revealed {
+		return false
+	}
+	if m.seasons != nil && !m.seasons[c.Season] {
+		return false
+	}
+	if m.q.Category != "" && !strings.EqualFold(c.Category, m.q.Category) {
+		return false
+	}
+	if m.category != nil && !m.category.MatchString(c.Category) {
+		return false
+	}
+	if m.rounds != nil && !m.rounds[c.Round] {
+		return false
+	}
+	if m.q.MinValue > 0 && (c.Value == 0 || c.Value < m.q.MinValue) {
+		return false
+	}
+	if m.q.MaxValue > 0 && (c.Value == 0 || c.Value > m.q.MaxValue) {
+		return false
+	}
+	if m.q.After != "" && (c.AirDate == "" || c.AirDate < m.q.After) {
+		return false
+	}
+	if m.q.Before != "" && (c.AirDate == "" || c.AirDate > m.q.Before) {
+		return false
+	}
+	if m.q.DailyDouble && !c.DailyDouble || m.q.TripleStumper && !c.TripleStumper {
+		return false
+	}
+	if len(m.words) == 0 {
+		return true
+	}
+	var text []string
+	if m.fields == nil || m.fields[FieldQuestion] {
+		text = append(text, c.Question)
+	}
+	if m.fields == nil || m.fields[FieldAnswer] {
+		text = append(text, c.Answer)
+	}
+	if m.fields == nil || m.fields[FieldCategory] {
+		text = append(text, c.Category)
+	}
+	haystack := strings.ToLower(strings.Join(text, "\n"))
+	for _, w := range m.words {
+		if !strings.Contains(haystack, w) {
+			return false
+		}
+	}
+	return true
+}
+
+// prints matches for reading in a terminal: where each clue was played,
+// then the clue and its response
+func WriteText(w io.Writer, clues []dataset.Clue) error {
+	for _, c := range clues {
+		where := fmt.Sprintf("season %s, #%s (%s), %s: %s", c.Season, c.EpisodeNumber, c.AirDate, c.Round, c.Category)
+		if c.Value != 0 {
+			where += " " + c.ValueRaw
+		}
+		fmt.Fprintln(w, where)
+		fmt.Fprintf(w, "  %s\n  -> %s\n\n", c.Question, c.Answer)
+	}
+	_, err := fmt.Fprintf(w, "%d clues\n", len(clues))
+	return err
+}
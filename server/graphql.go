@@ -0,0 +1,344 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/graphql-go/graphql"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+	"j-parser-go/search"
+)
+
+// roundOf is a round of a game as GraphQL sees it
+type roundOf struct {
+	game  *Game
+	round jarchive.Round
+}
+
+// builds the GraphQL schema over the server's games and clues
+func (s *Server) graphQLSchema() (graphql.Schema, error) {
+	contestantType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Contestant",
+		Description: "A player as listed at the top of the game page, or a team in team games",
+		Fields: graphql.Fields{
+			"name":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(c jarchive.Contestant) any { return c.Name })},
+			"playerId":    &graphql.Field{Type: graphql.String, Resolve: field(func(c jarchive.Contestant) any { return c.PlayerID })},
+			"description": &graphql.Field{Type: graphql.String, Resolve: field(func(c jarchive.Contestant) any { return c.Description })},
+		},
+	})
+	contestantType.AddFieldConfig("members", &graphql.Field{
+		Type:        graphql.NewList(contestantType),
+		Description: "a team's players, null for everyone else",
+		Resolve:     field(func(c jarchive.Contestant) any { return c.Members }),
+	})
+
+	tournamentType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Tournament",
+		Description: "The tournament or special event a game belongs to",
+		Fields: graphql.Fields{
+			"name":  &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(t *jarchive.Tournament) any { return t.Name })},
+			"stage": &graphql.Field{Type: graphql.String, Description: "quarterfinal, semifinal or final", Resolve: field(func(t *jarchive.Tournament) any { return t.Stage })},
+			"game":  &graphql.Field{Type: graphql.Int, Description: "the game's number within its stage, 0 if not known", Resolve: field(func(t *jarchive.Tournament) any { return t.Game })},
+		},
+	})
+
+	// Game, Round and Clue refer to each other, so their fields are added
+	// once all three exist
+	gameType := graphql.NewObject(graphql.ObjectConfig{Name: "Game", Fields: graphql.Fields{}})
+	roundType := graphql.NewObject(graphql.ObjectConfig{Name: "Round", Fields: graphql.Fields{}})
+	clueType := graphql.NewObject(graphql.ObjectConfig{Name: "Clue", Fields: graphql.Fields{}})
+
+	clueFields := graphql.Fields{
+		"id":            &graphql.Field{Type: graphql.NewNonNull(graphql.String), Description: "stable ID, the clue_id column", Resolve: field(func(c dataset.Clue) any { return c.ID() })},
+		"season":        &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(c dataset.Clue) any { return c.Season })},
+		"gameId":        &graphql.Field{Type: graphql.String, Description: "J! Archive's game_id", Resolve: field(func(c dataset.Clue) any { return c.GameID })},
+		"episodeNumber": &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(c dataset.Clue) any { return c.EpisodeNumber })},
+		"airDate":       &graphql.Field{Type: graphql.String, Resolve: field(func(c dataset.Clue) any { return c.AirDate })},
+		"round":         &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(c dataset.Clue) any { return c.Round })},
+		"category":      &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(c dataset.Clue) any { return c.Category })},
+		"value":         &graphql.Field{Type: graphql.Int, Description: "0 when unknown", Resolve: field(func(c dataset.Clue) any { return c.Value })},
+		"valueRaw":      &graphql.Field{Type: graphql.String, Resolve: field(func(c dataset.Clue) any { return c.ValueRaw })},
+		"dailyDouble":   &graphql.Field{Type: graphql.Boolean, Resolve: field(func(c dataset.Clue) any { return c.DailyDouble })},
+		"column":        &graphql.Field{Type: graphql.Int, Resolve: field(func(c dataset.Clue) any { return c.Column })},
+		"row":           &graphql.Field{Type: graphql.Int, Resolve: field(func(c dataset.Clue) any { return c.Row })},
+		"question":      &graphql.Field{Type: graphql.String, Resolve: field(func(c dataset.Clue) any { return c.Question })},
+		"clueNotes":     &graphql.Field{Type: graphql.String, Description: "asides moved out of the question, such as the host's", Resolve: field(func(c dataset.Clue) any { return c.Notes })},
+		"answer":        &graphql.Field{Type: graphql.String, Resolve: field(func(c dataset.Clue) any { return c.Answer })},
+		"revealed":      &graphql.Field{Type: graphql.Boolean, Resolve: field(func(c dataset.Clue) any { return c.Revealed })},
+		"tripleStumper": &graphql.Field{Type: graphql.Boolean, Resolve: field(func(c dataset.Clue) any { return c.TripleStumper })},
+		"game": &graphql.Field{Type: gameType, Resolve: field(func(c dataset.Clue) any {
+			if g, ok := s.byKey[gameKey{c.Season, c.EpisodeNumber}]; ok {
+				return s.game(g)
+			}
+			return nil
+		})},
+	}
+	for name, f := range clueFields {
+		clueType.AddFieldConfig(name, f)
+	}
+
+	roundType.AddFieldConfig("name", &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(r roundOf) any { return r.round.Name })})
+	roundType.AddFieldConfig("categories", &graphql.Field{Type: graphql.NewList(graphql.String), Resolve: field(func(r roundOf) any { return r.round.Categories })})
+	roundType.AddFieldConfig("clues", &graphql.Field{Type: graphql.NewList(clueType), Resolve: field(func(r roundOf) any {
+		var clues []dataset.Clue
+		for _, c := range r.round.Clues {
+			clues = append(clues, r.game.clue(c))
+		}
+		return clues
+	})})
+	roundType.AddFieldConfig("game", &graphql.Field{Type: gameType, Resolve: field(func(r roundOf) any { return r.game })})
+
+	gameType.AddFieldConfig("season", &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(g *Game) any { return g.Season })})
+	gameType.AddFieldConfig("gameId", &graphql.Field{Type: graphql.String, Description: "J! Archive's game_id", Resolve: field(func(g *Game) any { return g.GameID })})
+	gameType.AddFieldConfig("episodeNumber", &graphql.Field{Type: graphql.NewNonNull(graphql.String), Resolve: field(func(g *Game) any { return g.EpisodeNumber })})
+	gameType.AddFieldConfig("airDate", &graphql.Field{Type: graphql.String, Resolve: field(func(g *Game) any { return g.AirDate })})
+	gameType.AddFieldConfig("host", &graphql.Field{Type: graphql.String, Description: "empty when not known", Resolve: field(func(g *Game) any { return g.Host })})
+	gameType.AddFieldConfig("format", &graphql.Field{Type: graphql.String, Description: "regular, celebrity or team", Resolve: field(func(g *Game) any { return g.Format })})
+	gameType.AddFieldConfig("tournament", &graphql.Field{
+		Type:        tournamentType,
+		Description: "null for regular games",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			if t := p.Source.(*Game).Tournament; t != nil {
+				return t, nil
+			}
+			return nil, nil
+		},
+	})
+	gameType.AddFieldConfig("rounds", &graphql.Field{
+		Type: graphql.NewList(roundType),
+		Args: graphql.FieldConfigArgument{"name": {Type: graphql.String, Description: "only the round with this name, or J, DJ, TJ, FJ or TB"}},
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			g := p.Source.(*Game)
+			want := ""
+			if name, ok := p.Args["name"].(string); ok {
+				round, err := search.ParseRound(name)
+				if err != nil {
+					return nil, err
+				}
+				want = round
+			}
+			var rounds []roundOf
+			for _, r := range g.Rounds {
+				if want == "" || r.Name == want {
+					rounds = append(rounds, roundOf{game: g, round: r})
+				}
+			}
+			return rounds, nil
+		},
+	})
+	gameType.AddFieldConfig("contestants", &graphql.Field{
+		Type:        graphql.NewList(contestantType),
+		Description: "read from the episode's page in the archive directory; empty if it isn't there",
+		Resolve: func(p graphql.ResolveParams) (any, error) {
+			return s.contestants(p.Source.(*Game)), nil
+		},
+	})
+
+	filterArgs := graphql.FieldConfigArgument{
+		"q":             {Type: graphql.String, Description: "words that must all appear"},
+		"fields":        {Type: graphql.NewList(graphql.String)},
+		"season":        {Type: graphql.NewList(graphql.String)},
+		"round":         {Type: graphql.NewList(graphql.String)},
+		"category":      {Type: graphql.String},
+		"categoryRegex": {Type: graphql.String},
+		"value":         {Type: graphql.String, Description: "1600, >=1600, <=800 or 800-1600"},
+		"minValue":      {Type: graphql.Int},
+		"maxValue":      {Type: graphql.Int},
+	}
+	withArgs := func(extra graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+		args := graphql.FieldConfigArgument{}
+		for k, v := range filterArgs {
+			args[k] = v
+		}
+		for k, v := range extra {
+			args[k] = v
+		}
+		return args
+	}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"game": &graphql.Field{
+				Type: gameType,
+				Args: graphql.FieldConfigArgument{
+					"id":     {Type: graphql.NewNonNull(graphql.String), Description: "show number"},
+					"season": {Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					season, _ := p.Args["season"].(string)
+					g := s.findGame(p.Args["id"].(string), season)
+					if g == nil {
+						return nil, nil
+					}
+					return s.game(*g), nil
+				},
+			},
+			"games": &graphql.Field{
+				Type: graphql.NewList(gameType),
+				Args: graphql.FieldConfigArgument{
+					"season": {Type: graphql.String},
+					"limit":  {Type: graphql.Int, DefaultValue: defaultLimit},
+					"offset": {Type: graphql.Int, DefaultValue: 0},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					season, _ := p.Args["season"].(string)
+					var games []*Game
+					skip := p.Args["offset"].(int)
+					limit := min(p.Args["limit"].(int), maxLimit)
+					for _, g := range s.order {
+						if season != "" && g.season != season {
+							continue
+						}
+						if skip > 0 {
+							skip--
+							continue
+						}
+						if len(games) == limit {
+							break
+						}
+						games = append(games, s.game(g))
+					}
+					return games, nil
+				},
+			},
+			"clues": &graphql.Field{
+				Type: graphql.NewList(clueType),
+				Args: withArgs(graphql.FieldConfigArgument{
+					"limit":  {Type: graphql.Int, DefaultValue: defaultLimit},
+					"offset": {Type: graphql.Int, DefaultValue: 0},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					q, err := graphQLQuery(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					matches := search.Search(s.clues, q)
+					offset := max(p.Args["offset"].(int), 0)
+					limit := min(p.Args["limit"].(int), maxLimit)
+					if offset >= len(matches) {
+						return []dataset.Clue{}, nil
+					}
+					return matches[offset:min(offset+limit, len(matches))], nil
+				},
+			},
+			"random": &graphql.Field{
+				Type: graphql.NewList(clueType),
+				Args: withArgs(graphql.FieldConfigArgument{
+					"count": {Type: graphql.Int, DefaultValue: 1},
+				}),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					q, err := graphQLQuery(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					count := max(min(p.Args["count"].(int), maxLimit), 0)
+					return search.Random(s.clues, q, count, newRand()), nil
+				},
+			},
+		},
+	})
+	return graphql.NewSchema(graphql.SchemaConfig{Query: query})
+}
+
+// returns a resolver calling get with the source value
+func field[T any](get func(T) any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		src, ok := p.Source.(T)
+		if !ok {
+			return nil, fmt.Errorf("unexpected %T", p.Source)
+		}
+		return get(src), nil
+	}
+}
+
+// turns the filter arguments of clues and random into a search.Query
+func graphQLQuery(args map[string]any) (search.Query, error) {
+	strings := func(name string) []string {
+		var list []string
+		if items, ok := args[name].([]any); ok {
+			for _, item := range items {
+				if s, ok := item.(string); ok {
+					list = append(list, s)
+				}
+			}
+		}
+		return list
+	}
+	q := search.Query{Fields: strings("fields"), Seasons: strings("season"), Rounds: strings("round")}
+	q.Text, _ = args["q"].(string)
+	q.Category, _ = args["category"].(string)
+	q.CategoryRegex, _ = args["categoryRegex"].(string)
+	q.MinValue, _ = args["minValue"].(int)
+	q.MaxValue, _ = args["maxValue"].(int)
+	if value, ok := args["value"].(string); ok && value != "" {
+		var err error
+		if q.MinValue, q.MaxValue, err = search.ParseValueRange(value); err != nil {
+			return q, fmt.Errorf("invalid value: %v", err)
+		}
+	}
+	return q, q.Validate()
+}
+
+// reads a game's contestants from its page in the archive, if it is there
+func (s *Server) contestants(g *Game) []jarchive.Contestant {
+	if s.opts.ArchiveDir == "" {
+		return nil
+	}
+	path := filepath.Join(s.opts.ArchiveDir, "season "+g.Season, g.EpisodeNumber+".html")
+	if _, err := os.Stat(path); err != nil {
+		return nil
+	}
+	game, err := jarchive.ParseFile(path)
+	if err != nil {
+		slog.Warn("error reading contestants", "season", g.Season, "epNum", g.EpisodeNumber, "file", path, "err", err)
+		return nil
+	}
+	return game.Contestants
+}
+
+// graphQLRequest is the body of a POST /graphql, or the parameters of a GET
+type graphQLRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// GET or POST /graphql: runs a GraphQL query against the schema
+func (s *Server) handleGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req graphQLRequest
+	if r.Method == http.MethodPost {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid request body: "+err.Error())
+			return
+		}
+	} else {
+		params := r.URL.Query()
+		req.Query = params.Get("query")
+		req.OperationName = params.Get("operationName")
+		if vars := params.Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				writeError(w, http.StatusBadRequest, "invalid variables: "+err.Error())
+				return
+			}
+		}
+	}
+	if req.Query == "" {
+		writeError(w, http.StatusBadRequest, "no query")
+		return
+	}
+	res := graphql.Do(graphql.Params{
+		Schema:         s.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        r.Context(),
+	})
+	writeJSON(w, http.StatusOK, res)
+}
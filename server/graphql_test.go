@@ -0,0 +1,136 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// serves the golden CSVs with an archive holding the regular and team
+// fixtures' pages, for the contestants
+func newGraphQLServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	archive := t.TempDir()
+	for season, ep := range map[string]string{"regular": "9000", "team": "8012"} {
+		data, err := os.ReadFile(filepath.Join("..", "jarchive", "testdata", season+".html"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		dir := filepath.Join(archive, "season "+season)
+		if err := os.Mkdir(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, ep+".html"), data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv, _ := newTestServer(t, Options{ArchiveDir: archive})
+	return srv
+}
+
+// posts a query to /graphql and returns the status and body
+func postGraphQL(t *testing.T, srv *httptest.Server, query string) (int, []byte) {
+	t.Helper()
+	body, err := json.Marshal(graphQLRequest{Query: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := srv.Client().Post(srv.URL+"/graphql", "application/json", bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var out bytes.Buffer
+	if _, err := out.ReadFrom(resp.Body); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, out.Bytes()
+}
+
+// runs each testdata/graphql/<name>.graphql query against the resolvers
+// and compares the indented response with <name>.golden.json next to it
+func TestGoldenGraphQL(t *testing.T) {
+	srv := newGraphQLServer(t)
+	queries, err := filepath.Glob(filepath.Join("testdata", "graphql", "*.graphql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queries) == 0 {
+		t.Fatal("no queries in testdata/graphql")
+	}
+	for _, path := range queries {
+		name := strings.TrimSuffix(filepath.Base(path), ".graphql")
+		t.Run(name, func(t *testing.T) {
+			query, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			status, body := postGraphQL(t, srv, string(query))
+			if status != http.StatusOK {
+				t.Fatalf("status %d: %s", status, body)
+			}
+			var got bytes.Buffer
+			if err := json.Indent(&got, body, "", "  "); err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "graphql", name+".golden.json"), got.Bytes())
+		})
+	}
+}
+
+// runs a query with variables as GET parameters, and checks that random
+// only picks clues matching its filters
+func TestGraphQLGet(t *testing.T) {
+	srv := newGraphQLServer(t)
+	params := url.Values{
+		"query":     {`query Pick($season: String!) { random(season: [$season], count: 3) { season round } }`},
+		"variables": {`{"season": "tiebreaker"}`},
+	}
+	status, body := get(t, srv, "/graphql?"+params.Encode())
+	if status != http.StatusOK {
+		t.Fatalf("status %d: %s", status, body)
+	}
+	var res struct {
+		Data struct {
+			Random []struct{ Season, Round string }
+		}
+		Errors []any
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) > 0 || len(res.Data.Random) != 3 {
+		t.Fatalf("got %d clues and errors %v, want 3 and none", len(res.Data.Random), res.Errors)
+	}
+	for _, c := range res.Data.Random {
+		if c.Season != "tiebreaker" {
+			t.Errorf("picked a clue from season %s", c.Season)
+		}
+	}
+}
+
+// checks the requests /graphql turns away before running a query
+func TestGraphQLBadRequests(t *testing.T) {
+	srv := newGraphQLServer(t)
+	for _, path := range []string{"/graphql", "/graphql?query={games{season}}&variables=nope"} {
+		if status, body := get(t, srv, path); status != http.StatusBadRequest {
+			t.Errorf("%s: got status %d (%s), want 400", path, status, body)
+		}
+	}
+	resp, err := srv.Client().Post(srv.URL+"/graphql", "application/json", strings.NewReader("{"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("invalid body: got status %d, want 400", resp.StatusCode)
+	}
+}
@@ -0,0 +1,334 @@
+// Package server serves the parsed archive over HTTP as JSON, so trivia
+// apps and bots can use it without loading the CSVs themselves.
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+	"j-parser-go/search"
+)
+
+// returned by /clues when no limit is given, and the most it returns at once
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+// Options configures a Server
+type Options struct {
+	// directory holding the downloaded season folders, where GraphQL reads
+	// contestants from; they are left out if empty
+	ArchiveDir string
+}
+
+// Server answers API requests from clues held in memory
+type Server struct {
+	opts  Options
+	clues []dataset.Clue
+	// where each game's rows are in clues, in clues' order, by show number
+	// and by season and show number
+	order  []gameRows
+	games  map[string][]gameRows
+	byKey  map[gameKey]gameRows
+	schema graphql.Schema
+	mux    *http.ServeMux
+}
+
+// gameKey identifies a game: show numbers aren't unique across every season
+type gameKey struct{ season, epNum string }
+
+// gameRows is where one game's rows are in Server.clues
+type gameRows struct {
+	season     string
+	start, end int
+}
+
+// Game is a game as returned by /games/{id}. The CSVs don't list
+// contestants or comments, so those are always empty.
+type Game struct {
+	Season string
+	jarchive.Game
+}
+
+// encodes the game with each clue's ID first among its fields, leaving
+// "&" and other HTML characters as they are
+func (g Game) MarshalJSON() ([]byte, error) {
+	type fields Game
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	err := enc.Encode(struct {
+		fields
+		Rounds []jarchive.IdentifiedRound
+	}{fields(g), g.IdentifiedRounds(g.Season)})
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), err
+}
+
+// ClueList is a page of clues as returned by /clues
+type ClueList struct {
+	// matching clues in total, of which Clues is the page from Offset
+	Total  int
+	Offset int
+	Clues  []dataset.Clue
+}
+
+// returns a Server for clues, which must be in dataset.Load's order
+func New(clues []dataset.Clue, opts Options) (*Server, error) {
+	s := &Server{
+		opts:  opts,
+		clues: clues,
+		games: make(map[string][]gameRows),
+		byKey: make(map[gameKey]gameRows),
+		mux:   http.NewServeMux(),
+	}
+	for i := 0; i < len(clues); {
+		j := i
+		for j < len(clues) && clues[j].Season == clues[i].Season && clues[j].EpisodeNumber == clues[i].EpisodeNumber {
+			j++
+		}
+		g := gameRows{season: clues[i].Season, start: i, end: j}
+		ep := clues[i].EpisodeNumber
+		s.order = append(s.order, g)
+		s.games[ep] = append(s.games[ep], g)
+		s.byKey[gameKey{g.season, ep}] = g
+		i = j
+	}
+	schema, err := s.graphQLSchema()
+	if err != nil {
+		return nil, fmt.Errorf("error building GraphQL schema: %v", err)
+	}
+	s.schema = schema
+	s.mux.HandleFunc("GET /games/{id}", s.handleGame)
+	s.mux.HandleFunc("GET /clues", s.handleClues)
+	s.mux.HandleFunc("GET /random", s.handleRandom)
+	s.mux.HandleFunc("GET /graphql", s.handleGraphQL)
+	s.mux.HandleFunc("POST /graphql", s.handleGraphQL)
+	return s, nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	slog.Debug("request", "method", r.Method, "url", r.URL.String())
+	s.mux.ServeHTTP(w, r)
+}
+
+// GET /games/{id}: the game with that show number; ?season= picks one when
+// a number was used in more than one season
+func (s *Server) handleGame(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	g := s.findGame(id, r.URL.Query().Get("season"))
+	if g == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("no game %q", id))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.game(*g))
+}
+
+// returns the first game with the show number, in season if it's given
+func (s *Server) findGame(id, season string) *gameRows {
+	for _, g := range s.games[id] {
+		if season == "" || g.season == season {
+			return &g
+		}
+	}
+	return nil
+}
+
+// builds a game from its rows, rounds in the order they're played and
+// each round's clues in board order
+func (s *Server) game(g gameRows) *Game {
+	rows := s.clues[g.start:g.end]
+	game := &Game{Season: g.season}
+	game.GameID = rows[0].GameID
+	game.EpisodeNumber = rows[0].EpisodeNumber
+	game.AirDate = rows[0].AirDate
+	game.Host = rows[0].Host
+	game.Format = rows[0].Format
+	if rows[0].Tournament != "" {
+		game.Tournament = &jarchive.Tournament{Name: rows[0].Tournament, Stage: rows[0].TournamentStage, Game: rows[0].TournamentGame}
+	}
+	for _, name := range []string{jarchive.RoundJeopardy, jarchive.RoundDoubleJeopardy, jarchive.RoundTripleJeopardy, jarchive.RoundFinalJeopardy, jarchive.RoundTiebreaker} {
+		round := jarchive.Round{Name: name}
+		for _, c := range rows {
+			if c.Round == name {
+				round.Clues = append(round.Clues, c.Clue)
+			}
+		}
+		if len(round.Clues) == 0 {
+			continue
+		}
+		sortBoard(round.Clues)
+		round.Categories = categories(round.Clues)
+		game.Rounds = append(game.Rounds, round)
+	}
+	return game
+}
+
+// returns one of the game's clues as a CSV row would have it
+func (g *Game) clue(c jarchive.Clue) dataset.Clue {
+	row := dataset.Clue{Season: g.Season, GameID: g.GameID, EpisodeNumber: g.EpisodeNumber, AirDate: g.AirDate, Host: g.Host, Format: g.Format, Clue: c}
+	if t := g.Tournament; t != nil {
+		row.Tournament, row.TournamentStage, row.TournamentGame = t.Name, t.Stage, t.Game
+	}
+	return row
+}
+
+// GET /clues: clues matching the query parameters, a page at a time
+func (s *Server) handleClues(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	params := r.URL.Query()
+	limit, err := intParam(params.Get("limit"), defaultLimit)
+	if err != nil || limit < 1 {
+		writeError(w, http.StatusBadRequest, "limit must be a positive number")
+		return
+	}
+	limit = min(limit, maxLimit)
+	offset, err := intParam(params.Get("offset"), 0)
+	if err != nil || offset < 0 {
+		writeError(w, http.StatusBadRequest, "offset must be a number, 0 or more")
+		return
+	}
+
+	matches := search.Search(s.clues, q)
+	list := ClueList{Total: len(matches), Offset: offset, Clues: []dataset.Clue{}}
+	if offset < len(matches) {
+		list.Clues = matches[offset:min(offset+limit, len(matches))]
+	}
+	writeJSON(w, http.StatusOK, list)
+}
+
+// GET /random: random clues matching the query parameters, one unless
+// ?count= asks for more
+func (s *Server) handleRandom(w http.ResponseWriter, r *http.Request) {
+	q, err := parseQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	count, err := intParam(r.URL.Query().Get("count"), 1)
+	if err != nil || count < 1 {
+		writeError(w, http.StatusBadRequest, "count must be a positive number")
+		return
+	}
+	count = min(count, maxLimit)
+
+	picked := search.Random(s.clues, q, count, newRand())
+	if len(picked) == 0 {
+		writeError(w, http.StatusNotFound, "no clues match")
+		return
+	}
+	writeJSON(w, http.StatusOK, picked)
+}
+
+// reads the search filters shared by /clues and /random: q, fields,
+// season, round, category, category_regex, value, min_value and
+// max_value. Lists are comma-separated.
+func parseQuery(r *http.Request) (search.Query, error) {
+	params := r.URL.Query()
+	q := search.Query{
+		Text:          params.Get("q"),
+		Fields:        splitParam(params.Get("fields")),
+		Seasons:       splitParam(params.Get("season")),
+		Rounds:        splitParam(params.Get("round")),
+		Category:      params.Get("category"),
+		CategoryRegex: params.Get("category_regex"),
+	}
+	var err error
+	if q.MinValue, err = intParam(params.Get("min_value"), 0); err != nil {
+		return q, fmt.Errorf("invalid min_value: %v", err)
+	}
+	if q.MaxValue, err = intParam(params.Get("max_value"), 0); err != nil {
+		return q, fmt.Errorf("invalid max_value: %v", err)
+	}
+	if value := params.Get("value"); value != "" {
+		if q.MinValue, q.MaxValue, err = search.ParseValueRange(value); err != nil {
+			return q, fmt.Errorf("invalid value: %v", err)
+		}
+	}
+	return q, q.Validate()
+}
+
+// returns a randomly seeded source for one request
+func newRand() *rand.Rand {
+	return rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+}
+
+func splitParam(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parses an integer parameter, def if it's missing
+func intParam(s string, def int) (int, error) {
+	if s == "" {
+		return def, nil
+	}
+	return strconv.Atoi(s)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		slog.Warn("error writing response", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
+}
+
+// puts a round's clues back in board order, left to right then top to
+// bottom; the CSVs have them sorted by category and value
+func sortBoard(clues []jarchive.Clue) {
+	sort.SliceStable(clues, func(i, j int) bool {
+		if clues[i].Row != clues[j].Row {
+			return clues[i].Row < clues[j].Row
+		}
+		return clues[i].Column < clues[j].Column
+	})
+}
+
+// returns a round's categories left to right, by board column where the
+// clues have one and otherwise in the order they first appear
+func categories(clues []jarchive.Clue) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, c := range clues {
+		if seen[c.Category] {
+			continue
+		}
+		seen[c.Category] = true
+		if c.Column > 0 {
+			for len(names) < c.Column {
+				names = append(names, "")
+			}
+			names[c.Column-1] = c.Category
+		} else {
+			names = append(names, c.Category)
+		}
+	}
+	return names
+}
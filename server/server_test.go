@@ -0,0 +1,144 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/dataset"
+	"j-parser-go/internal/golden"
+	"j-parser-go/search"
+)
+
+// serves each of the parse package's golden CSVs as a season
+func newTestServer(t *testing.T, opts Options) (*httptest.Server, []dataset.Clue) {
+	t.Helper()
+	clues, err := dataset.Load(dataset.Options{Dir: golden.Seasons(t)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := New(clues, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return srv, clues
+}
+
+// requests path and returns the status and body
+func get(t *testing.T, srv *httptest.Server, path string) (int, []byte) {
+	t.Helper()
+	resp, err := srv.Client().Get(srv.URL + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("%s: Content-Type %q, want application/json", path, ct)
+	}
+	return resp.StatusCode, body
+}
+
+// compares /games/{id}, indented, for a game with a J! Archive game_id
+// and one without with testdata/games/<id>.golden.json
+func TestGoldenGames(t *testing.T) {
+	srv, _ := newTestServer(t, Options{})
+	for _, id := range []string{"9000", "2481"} {
+		t.Run(id, func(t *testing.T) {
+			status, body := get(t, srv, "/games/"+id)
+			if status != http.StatusOK {
+				t.Fatalf("status %d: %s", status, body)
+			}
+			var got bytes.Buffer
+			if err := json.Indent(&got, body, "", "  "); err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", "games", id+".golden.json"), got.Bytes())
+		})
+	}
+}
+
+// pages through /clues and checks each page against search.Search
+func TestClues(t *testing.T) {
+	srv, clues := newTestServer(t, Options{})
+	want := search.Search(clues, search.Query{Category: "rivers"})
+	if len(want) < 3 {
+		t.Fatalf("only %d clues in RIVERS; the paging tests nothing", len(want))
+	}
+	status, body := get(t, srv, "/clues?category=rivers&limit=2&offset=1")
+	if status != http.StatusOK {
+		t.Fatalf("status %d: %s", status, body)
+	}
+	var list struct {
+		Total, Offset int
+		Clues         []struct{ ID, Season, Category string }
+	}
+	if err := json.Unmarshal(body, &list); err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != len(want) || list.Offset != 1 || len(list.Clues) != 2 {
+		t.Fatalf("got a total of %d, offset %d and %d clues; want %d, 1 and 2", list.Total, list.Offset, len(list.Clues), len(want))
+	}
+	for i, c := range list.Clues {
+		if w := &want[i+1]; c.ID != w.ID() || c.Season != w.Season {
+			t.Errorf("clue %d: got %s in season %s, want %s in season %s", i, c.ID, c.Season, w.ID(), w.Season)
+		}
+	}
+}
+
+// checks that /random only picks clues matching the filters
+func TestRandom(t *testing.T) {
+	srv, _ := newTestServer(t, Options{})
+	status, body := get(t, srv, "/random?season=regular&round=DJ&count=3")
+	if status != http.StatusOK {
+		t.Fatalf("status %d: %s", status, body)
+	}
+	var picked []struct{ Season, Round string }
+	if err := json.Unmarshal(body, &picked); err != nil {
+		t.Fatal(err)
+	}
+	if len(picked) != 3 {
+		t.Fatalf("got %d clues, want 3", len(picked))
+	}
+	for _, c := range picked {
+		if c.Season != "regular" || c.Round != "Double Jeopardy" {
+			t.Errorf("picked a clue from season %s, round %s", c.Season, c.Round)
+		}
+	}
+}
+
+// checks the statuses and messages of requests that can't be answered
+func TestErrors(t *testing.T) {
+	srv, _ := newTestServer(t, Options{})
+	for _, tt := range []struct {
+		path   string
+		status int
+	}{
+		{"/games/1", http.StatusNotFound},
+		{"/games/9000?season=team", http.StatusNotFound},
+		{"/clues?limit=0", http.StatusBadRequest},
+		{"/clues?offset=-1", http.StatusBadRequest},
+		{"/clues?value=lots", http.StatusBadRequest},
+		{"/clues?fields=notes", http.StatusBadRequest},
+		{"/random?count=0", http.StatusBadRequest},
+		{"/random?category=nothing+like+it", http.StatusNotFound},
+	} {
+		status, body := get(t, srv, tt.path)
+		var msg struct{ Error string }
+		if err := json.Unmarshal(body, &msg); err != nil || msg.Error == "" {
+			t.Errorf("%s: got %s, want an error message", tt.path, body)
+		}
+		if status != tt.status {
+			t.Errorf("%s: got status %d, want %d", tt.path, status, tt.status)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"j-parser-go/download"
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+// Career is one contestant's games across every season parsed
+type Career struct {
+	// J! Archive's player id, empty when the game pages don't link one; the
+	// contestant is then told apart by name alone
+	PlayerID string `json:"playerId"`
+	Name     string `json:"name"`
+	// every game played, in air date order
+	Appearances []Appearance `json:"appearances"`
+	Wins        int          `json:"wins"`
+	// the most games won in a row
+	LongestStreak int `json:"longestStreak"`
+	// the final scores of the games won, which is what a champion keeps,
+	// and the same in jarchive.CPIYear dollars, each game's score adjusted
+	// for inflation from its air year (unadjusted when it has no air date)
+	Winnings         int `json:"winnings"`
+	WinningsAdjusted int `json:"winningsAdjusted"`
+}
+
+// Appearance is one game of a Career
+type Appearance struct {
+	Season        string `json:"season"`
+	GameID        string `json:"gameId"`
+	EpisodeNumber string `json:"epNum"`
+	AirDate       string `json:"airDate"`
+	// nil when the game page has no final scores
+	FinalScore *int `json:"finalScore"`
+	Won        bool `json:"won"`
+}
+
+// orders for SortCareers
+const (
+	ByWinnings    = "winnings"
+	ByWins        = "wins"
+	ByStreak      = "streak"
+	ByAppearances = "appearances"
+)
+
+// follows every contestant through the games, most winnings first. Team
+// games are left out, since their scores belong to a team rather than
+// to any one player.
+func Careers(seasons []parse.SeasonGames) []Career {
+	var careers []*Career
+	byKey := make(map[string]*Career)
+	for _, s := range seasons {
+		for _, g := range s.Games {
+			if g.Format == jarchive.FormatTeam {
+				continue
+			}
+			won := make(map[string]bool)
+			for _, w := range g.Winners() {
+				won[careerKey(w)] = true
+			}
+			for _, c := range g.Contestants {
+				key := careerKey(c)
+				if key == "" {
+					continue
+				}
+				career := byKey[key]
+				if career == nil {
+					career = &Career{PlayerID: c.PlayerID, Name: c.Name}
+					byKey[key] = career
+					careers = append(careers, career)
+				}
+				career.Appearances = append(career.Appearances, Appearance{Season: s.Season, GameID: g.GameID,
+					EpisodeNumber: g.EpisodeNumber, AirDate: g.AirDate, FinalScore: c.FinalScore, Won: won[key]})
+			}
+		}
+	}
+	out := make([]Career, 0, len(careers))
+	for _, c := range careers {
+		c.tally()
+		out = append(out, *c)
+	}
+	SortCareers(out, ByWinnings)
+	return out
+}
+
+// identifies a contestant across games by player id, or by name for
+// pages without player links
+func careerKey(c jarchive.Contestant) string {
+	if c.PlayerID != "" {
+		return "id:" + c.PlayerID
+	}
+	if c.Name == "" {
+		return ""
+	}
+	return "name:" + strings.ToLower(c.Name)
+}
+
+// puts the appearances in order and counts the wins, streak and winnings
+func (c *Career) tally() {
+	sort.SliceStable(c.Appearances, func(i, j int) bool {
+		a, b := c.Appearances[i], c.Appearances[j]
+		if a.AirDate != b.AirDate {
+			return a.AirDate < b.AirDate
+		}
+		return download.SeasonLess(a.EpisodeNumber, b.EpisodeNumber)
+	})
+	streak := 0
+	for _, a := range c.Appearances {
+		if !a.Won {
+			streak = 0
+			continue
+		}
+		c.Wins++
+		c.Winnings += *a.FinalScore
+		adjusted, ok := jarchive.AdjustForInflation(*a.FinalScore, a.AirDate)
+		if !ok {
+			adjusted = *a.FinalScore
+		}
+		c.WinningsAdjusted += adjusted
+		streak++
+		c.LongestStreak = max(c.LongestStreak, streak)
+	}
+}
+
+// sorts careers by one of ByWinnings, ByWins, ByStreak or ByAppearances,
+// largest first, with ties broken by name
+func SortCareers(careers []Career, order string) error {
+	var key func(Career) int
+	switch order {
+	case ByWinnings:
+		key = func(c Career) int { return c.Winnings }
+	case ByWins:
+		key = func(c Career) int { return c.Wins }
+	case ByStreak:
+		key = func(c Career) int { return c.LongestStreak }
+	case ByAppearances:
+		key = func(c Career) int { return len(c.Appearances) }
+	default:
+		return fmt.Errorf("unknown order %q (want %s, %s, %s or %s)", order, ByWinnings, ByWins, ByStreak, ByAppearances)
+	}
+	sort.SliceStable(careers, func(i, j int) bool {
+		if ki, kj := key(careers[i]), key(careers[j]); ki != kj {
+			return ki > kj
+		}
+		return careers[i].Name < careers[j].Name
+	})
+	return nil
+}
+
+// writes one row per contestant, with the games as "season/epNum" and the
+// game ids separated by "; "
+func WriteCareersCSV(w io.Writer, careers []Career) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"player_id", "name", "appearances", "wins", "longest_streak", "winnings", "winnings_adjusted", "first_air_date", "last_air_date", "games", "game_ids"})
+	for _, c := range careers {
+		var games, ids []string
+		for _, a := range c.Appearances {
+			games = append(games, a.Season+"/"+a.EpisodeNumber)
+			if a.GameID != "" {
+				ids = append(ids, a.GameID)
+			}
+		}
+		first, last := c.Appearances[0].AirDate, c.Appearances[len(c.Appearances)-1].AirDate
+		cw.Write([]string{c.PlayerID, c.Name, strconv.Itoa(len(c.Appearances)), strconv.Itoa(c.Wins),
+			strconv.Itoa(c.LongestStreak), strconv.Itoa(c.Winnings), strconv.Itoa(c.WinningsAdjusted), first, last, strings.Join(games, "; "), strings.Join(ids, "; ")})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes the careers as an indented JSON array, appearances included
+func WriteCareersJSON(w io.Writer, careers []Career) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(careers)
+}
@@ -0,0 +1,138 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"j-parser-go/dataset"
+)
+
+// Category is how often one category name was played, and when
+type Category struct {
+	Name string `json:"name"`
+	// times the category was played, counting each round of each game once
+	Count int `json:"count"`
+	// revealed clues from the category
+	Clues        int      `json:"clues"`
+	FirstAirDate string   `json:"firstAirDate"`
+	LastAirDate  string   `json:"lastAirDate"`
+	Seasons      []string `json:"seasons"`
+}
+
+// orders for SortCategories
+const (
+	ByCount = "count"
+	ByName  = "name"
+	ByFirst = "first"
+)
+
+// reads the season CSVs and returns every distinct category name, most
+// played first
+func Categories(opts Options) ([]Category, error) {
+	opts.setDefaults()
+	clues, err := dataset.Load(opts.dataset())
+	if err != nil {
+		return nil, err
+	}
+	return categories(clues), nil
+}
+
+// counts the categories in clues, keeping the order seasons first appear in
+func categories(clues []dataset.Clue) []Category {
+	type slot struct{ season, epNum, round string }
+	var cats []*Category
+	byName := make(map[string]*Category)
+	played := make(map[string]map[slot]bool)
+	inSeason := make(map[string]map[string]bool)
+	for _, c := range clues {
+		if c.Category == "" {
+			continue
+		}
+		cat := byName[c.Category]
+		if cat == nil {
+			cat = &Category{Name: c.Category}
+			byName[c.Category] = cat
+			cats = append(cats, cat)
+			played[c.Category] = make(map[slot]bool)
+			inSeason[c.Category] = make(map[string]bool)
+		}
+		if s := (slot{c.Season, c.EpisodeNumber, c.Round}); !played[c.Category][s] {
+			played[c.Category][s] = true
+			cat.Count++
+		}
+		if !inSeason[c.Category][c.Season] {
+			inSeason[c.Category][c.Season] = true
+			cat.Seasons = append(cat.Seasons, c.Season)
+		}
+		if c.Revealed {
+			cat.Clues++
+		}
+		if c.AirDate != "" {
+			// YYYY-MM-DD sorts as a string
+			if cat.FirstAirDate == "" || c.AirDate < cat.FirstAirDate {
+				cat.FirstAirDate = c.AirDate
+			}
+			if c.AirDate > cat.LastAirDate {
+				cat.LastAirDate = c.AirDate
+			}
+		}
+	}
+	list := make([]Category, 0, len(cats))
+	for _, cat := range cats {
+		list = append(list, *cat)
+	}
+	SortCategories(list, ByCount)
+	return list
+}
+
+// sorts categories by count (most played first), name or first air date,
+// breaking ties by name
+func SortCategories(cats []Category, order string) error {
+	var less func(a, b *Category) bool
+	switch order {
+	case ByCount:
+		less = func(a, b *Category) bool { return a.Count > b.Count }
+	case ByName:
+		less = func(a, b *Category) bool { return false }
+	case ByFirst:
+		less = func(a, b *Category) bool { return a.FirstAirDate < b.FirstAirDate }
+	default:
+		return fmt.Errorf("unknown order %q (want %s, %s or %s)", order, ByCount, ByName, ByFirst)
+	}
+	sort.SliceStable(cats, func(i, j int) bool {
+		a, b := &cats[i], &cats[j]
+		if less(a, b) || less(b, a) {
+			return less(a, b)
+		}
+		return a.Name < b.Name
+	})
+	return nil
+}
+
+// writes categories as CSV, seasons separated by spaces
+func WriteCategoriesCSV(w io.Writer, cats []Category) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"category", "count", "clues", "first_air_date", "last_air_date", "seasons"})
+	for _, c := range cats {
+		cw.Write([]string{c.Name, strconv.Itoa(c.Count), strconv.Itoa(c.Clues),
+			c.FirstAirDate, c.LastAirDate, strings.Join(c.Seasons, " ")})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes categories as an indented JSON array
+func WriteCategoriesJSON(w io.Writer, cats []Category) error {
+	if cats == nil {
+		cats = []Category{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(cats)
+}
@@ -0,0 +1,164 @@
+package stats
+
+import (
+	"strconv"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// names of the Daily Double CSVs, relative to Options.OutDir
+var dailyDoubleFiles = []string{
+	"daily-doubles-by-position.csv",
+	"daily-doubles-by-round.csv",
+	"daily-doubles-by-season.csv",
+}
+
+// rounds played on a board, the only ones with Daily Doubles
+var boardRounds = []string{jarchive.RoundJeopardy, jarchive.RoundDoubleJeopardy}
+
+// board size: categories across, values down
+const (
+	boardColumns = 6
+	boardRows    = 5
+)
+
+// DailyDoubleStats is where Daily Doubles were found, counted three ways
+type DailyDoubleStats struct {
+	ByPosition []PositionCount `json:"byPosition"`
+	ByRound    []RoundCount    `json:"byRound"`
+	BySeason   []SeasonCount   `json:"bySeason"`
+}
+
+// PositionCount is how often one square of a round's board held a Daily
+// Double. Rate is Count over the number of boards of that round.
+type PositionCount struct {
+	Round  string  `json:"round"`
+	Column int     `json:"column"`
+	Row    int     `json:"row"`
+	Count  int     `json:"count"`
+	Rate   float64 `json:"rate"`
+}
+
+// RoundCount is the Daily Doubles found in one round across every game
+type RoundCount struct {
+	Round        string  `json:"round"`
+	Boards       int     `json:"boards"`
+	DailyDoubles int     `json:"dailyDoubles"`
+	PerBoard     float64 `json:"perBoard"`
+}
+
+// SeasonCount is the Daily Doubles found in one season, split by round
+type SeasonCount struct {
+	Season         string  `json:"season"`
+	Games          int     `json:"games"`
+	Jeopardy       int     `json:"jeopardy"`
+	DoubleJeopardy int     `json:"doubleJeopardy"`
+	Total          int     `json:"total"`
+	PerGame        float64 `json:"perGame"`
+}
+
+// counts Daily Doubles by board square, round and season. clues must be in
+// season order, as dataset.Load returns them.
+func dailyDoubles(clues []dataset.Clue) *DailyDoubleStats {
+	type game struct{ season, epNum string }
+	type board struct {
+		game
+		round string
+	}
+	boards := make(map[board]bool)
+	squares := make(map[string]*[boardRows][boardColumns]int)
+	perRound := make(map[string]int)
+	for _, round := range boardRounds {
+		squares[round] = &[boardRows][boardColumns]int{}
+	}
+
+	var seasons []*SeasonCount
+	bySeason := make(map[string]*SeasonCount)
+	games := make(map[game]bool)
+	for _, c := range clues {
+		sc := bySeason[c.Season]
+		if sc == nil {
+			sc = &SeasonCount{Season: c.Season}
+			bySeason[c.Season] = sc
+			seasons = append(seasons, sc)
+		}
+		g := game{c.Season, c.EpisodeNumber}
+		if !games[g] {
+			games[g] = true
+			sc.Games++
+		}
+		grid, onBoard := squares[c.Round]
+		if !onBoard {
+			continue
+		}
+		boards[board{g, c.Round}] = true
+		if !c.DailyDouble {
+			continue
+		}
+		perRound[c.Round]++
+		switch c.Round {
+		case jarchive.RoundJeopardy:
+			sc.Jeopardy++
+		case jarchive.RoundDoubleJeopardy:
+			sc.DoubleJeopardy++
+		}
+		sc.Total++
+		// older CSVs have no board position
+		if c.Column >= 1 && c.Column <= boardColumns && c.Row >= 1 && c.Row <= boardRows {
+			grid[c.Row-1][c.Column-1]++
+		}
+	}
+
+	boardCount := make(map[string]int)
+	for b := range boards {
+		boardCount[b.round]++
+	}
+	st := &DailyDoubleStats{
+		ByPosition: []PositionCount{},
+		ByRound:    []RoundCount{},
+		BySeason:   []SeasonCount{},
+	}
+	for _, round := range boardRounds {
+		n := boardCount[round]
+		for row := 1; row <= boardRows; row++ {
+			for column := 1; column <= boardColumns; column++ {
+				count := squares[round][row-1][column-1]
+				st.ByPosition = append(st.ByPosition, PositionCount{
+					Round: round, Column: column, Row: row, Count: count, Rate: ratio(count, n),
+				})
+			}
+		}
+		st.ByRound = append(st.ByRound, RoundCount{
+			Round: round, Boards: n, DailyDoubles: perRound[round], PerBoard: ratio(perRound[round], n),
+		})
+	}
+	for _, sc := range seasons {
+		sc.PerGame = ratio(sc.Total, sc.Games)
+		st.BySeason = append(st.BySeason, *sc)
+	}
+	return st
+}
+
+// returns the CSV tables keyed by file name
+func (st *DailyDoubleStats) tables() map[string][][]string {
+	position := [][]string{{"round", "board_column", "board_row", "count", "rate"}}
+	for _, p := range st.ByPosition {
+		position = append(position, []string{p.Round, strconv.Itoa(p.Column), strconv.Itoa(p.Row),
+			strconv.Itoa(p.Count), formatRate(p.Rate)})
+	}
+	round := [][]string{{"round", "boards", "daily_doubles", "per_board"}}
+	for _, r := range st.ByRound {
+		round = append(round, []string{r.Round, strconv.Itoa(r.Boards), strconv.Itoa(r.DailyDoubles), formatRate(r.PerBoard)})
+	}
+	season := [][]string{{"season", "games", "jeopardy", "double_jeopardy", "total", "per_game"}}
+	for _, s := range st.BySeason {
+		season = append(season, []string{s.Season, strconv.Itoa(s.Games), strconv.Itoa(s.Jeopardy),
+			strconv.Itoa(s.DoubleJeopardy), strconv.Itoa(s.Total), formatRate(s.PerGame)})
+	}
+	return map[string][][]string{
+		dailyDoubleFiles[0]: position,
+		dailyDoubleFiles[1]: round,
+		dailyDoubleFiles[2]: season,
+	}
+}
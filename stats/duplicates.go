@@ -0,0 +1,118 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+
+	"j-parser-go/dataset"
+	"j-parser-go/jarchive"
+)
+
+// Duplicate is a clue whose text had already been played in an earlier
+// game, such as a rerun or a clue the writers used again
+type Duplicate struct {
+	Season        string `json:"season"`
+	EpisodeNumber string `json:"epNum"`
+	AirDate       string `json:"airDate"`
+	Round         string `json:"round"`
+	Category      string `json:"category"`
+	Question      string `json:"question"`
+	Answer        string `json:"answer"`
+	// the game where the text was first played, and its category there
+	FirstSeason        string `json:"firstSeason"`
+	FirstEpisodeNumber string `json:"firstEpNum"`
+	FirstAirDate       string `json:"firstAirDate"`
+	FirstCategory      string `json:"firstCategory"`
+	// how many games had the text so far, this one included: 2 for the
+	// first repeat
+	Times int `json:"times"`
+}
+
+// reads the season CSVs and returns every clue whose text was played in an
+// earlier game, in air date order
+func Duplicates(opts Options) ([]Duplicate, error) {
+	opts.setDefaults()
+	clues, err := dataset.Load(opts.dataset())
+	if err != nil {
+		return nil, err
+	}
+	return duplicates(clues), nil
+}
+
+// finds the repeated clue texts in clues. Texts are compared as
+// jarchive.NormalizeAnswer leaves them, ignoring case, punctuation and
+// spacing; a text counts once per game, and the first game to play it is
+// the one that aired first, or for games without an air date the first in
+// clues.
+func duplicates(clues []dataset.Clue) []Duplicate {
+	order := make([]int, 0, len(clues))
+	for i := range clues {
+		if clues[i].Revealed && clues[i].Question != "" {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool { return airedBefore(clues[order[a]].AirDate, clues[order[b]].AirDate) })
+
+	type gameText struct{ text, season, epNum string }
+	first := make(map[string]*dataset.Clue)
+	times := make(map[string]int)
+	seen := make(map[gameText]bool)
+	var dups []Duplicate
+	for _, i := range order {
+		c := &clues[i]
+		text := jarchive.NormalizeAnswer(c.Question)
+		k := gameText{text, c.Season, c.EpisodeNumber}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		times[text]++
+		f := first[text]
+		if f == nil {
+			first[text] = c
+			continue
+		}
+		dups = append(dups, Duplicate{
+			Season: c.Season, EpisodeNumber: c.EpisodeNumber, AirDate: c.AirDate, Round: c.Round,
+			Category: c.Category, Question: c.Question, Answer: c.Answer,
+			FirstSeason: f.Season, FirstEpisodeNumber: f.EpisodeNumber, FirstAirDate: f.AirDate, FirstCategory: f.Category,
+			Times: times[text],
+		})
+	}
+	return dups
+}
+
+// orders air dates, YYYY-MM-DD sorting as strings, with missing ones last
+func airedBefore(a, b string) bool {
+	if a == "" || b == "" {
+		return a != "" && b == ""
+	}
+	return a < b
+}
+
+// writes duplicates as CSV
+func WriteDuplicatesCSV(w io.Writer, dups []Duplicate) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"season", "epNum", "airDate", "round_name", "category", "question", "answer",
+		"first_season", "first_epNum", "first_airDate", "first_category", "times"})
+	for _, d := range dups {
+		cw.Write([]string{d.Season, d.EpisodeNumber, d.AirDate, d.Round, d.Category, d.Question, d.Answer,
+			d.FirstSeason, d.FirstEpisodeNumber, d.FirstAirDate, d.FirstCategory, strconv.Itoa(d.Times)})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writes duplicates as an indented JSON array
+func WriteDuplicatesJSON(w io.Writer, dups []Duplicate) error {
+	if dups == nil {
+		dups = []Duplicate{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(dups)
+}
@@ -0,0 +1,84 @@
+package stats
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"j-parser-go/internal/golden"
+	"j-parser-go/jarchive"
+	"j-parser-go/parse"
+)
+
+// treats each of the parse package's golden CSVs as a season, runs the
+// statistics over them and compares every report file with
+// testdata/<file>.golden
+func TestGoldenReports(t *testing.T) {
+	opts := Options{CSVDir: golden.Seasons(t), OutDir: t.TempDir()}
+	if _, err := Run(opts); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	for _, file := range Files(opts) {
+		name := filepath.Base(file)
+		t.Run(name, func(t *testing.T) {
+			got, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden.Compare(t, filepath.Join("testdata", name+".golden"), got)
+		})
+	}
+}
+
+// compares the category report over the same seasons with
+// testdata/categories.csv.golden
+func TestGoldenCategories(t *testing.T) {
+	cats, err := Categories(Options{CSVDir: golden.Seasons(t)})
+	if err != nil {
+		t.Fatalf("Categories: %v", err)
+	}
+	var got bytes.Buffer
+	if err := WriteCategoriesCSV(&got, cats); err != nil {
+		t.Fatal(err)
+	}
+	golden.Compare(t, filepath.Join("testdata", "categories.csv.golden"), got.Bytes())
+}
+
+// compares the duplicates report over the same seasons with
+// testdata/duplicates.csv.golden
+func TestGoldenDuplicates(t *testing.T) {
+	dups, err := Duplicates(Options{CSVDir: golden.Seasons(t)})
+	if err != nil {
+		t.Fatalf("Duplicates: %v", err)
+	}
+	var got bytes.Buffer
+	if err := WriteDuplicatesCSV(&got, dups); err != nil {
+		t.Fatal(err)
+	}
+	golden.Compare(t, filepath.Join("testdata", "duplicates.csv.golden"), got.Bytes())
+}
+
+// follows the contestants through the jarchive fixtures, one season each,
+// and compares the careers with testdata/careers.csv.golden
+func TestGoldenCareers(t *testing.T) {
+	fixtures, err := filepath.Glob(filepath.Join("..", "jarchive", "testdata", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var seasons []parse.SeasonGames
+	for _, fixture := range fixtures {
+		game, err := jarchive.ParseFile(fixture)
+		if err != nil {
+			t.Fatal(err)
+		}
+		season := strings.TrimSuffix(filepath.Base(fixture), ".html")
+		seasons = append(seasons, parse.SeasonGames{Season: season, Games: []*jarchive.Game{game}})
+	}
+	var got bytes.Buffer
+	if err := WriteCareersCSV(&got, Careers(seasons)); err != nil {
+		t.Fatal(err)
+	}
+	golden.Compare(t, filepath.Join("testdata", "careers.csv.golden"), got.Bytes())
+}
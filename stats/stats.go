@@ -0,0 +1,141 @@
+// Package stats computes statistics over the season CSVs written by the
+// parse package, so common analyses don't need a script of their own.
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"j-parser-go/dataset"
+)
+
+var (
+	csvFolder   = "parsed-csv"
+	statsFolder = "stats"
+)
+
+// Options controls which CSVs Run reads and where the reports go
+type Options struct {
+	// directory holding the season CSVs, "parsed-csv" if empty
+	CSVDir string
+	// directory the reports are written to, "stats" if empty
+	OutDir string
+	// only read these seasons; every season with a CSV if empty
+	Seasons []string
+}
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.CSVDir == "" {
+		o.CSVDir = csvFolder
+	}
+	if o.OutDir == "" {
+		o.OutDir = statsFolder
+	}
+}
+
+// Report is everything Run computes
+type Report struct {
+	Summary      *SummaryStats     `json:"summary"`
+	DailyDoubles *DailyDoubleStats `json:"dailyDoubles"`
+}
+
+// reads the season CSVs, computes the statistics and writes them to
+// opts.OutDir as JSON and CSV
+func Run(opts Options) (*Report, error) {
+	opts.setDefaults()
+	clues, err := dataset.Load(opts.dataset())
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Summary: summarize(clues), DailyDoubles: dailyDoubles(clues)}
+	if err := report.write(opts.OutDir); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// returns the seasons Run would read
+func Seasons(opts Options) ([]string, error) {
+	opts.setDefaults()
+	return dataset.Seasons(opts.dataset())
+}
+
+func (o *Options) dataset() dataset.Options {
+	return dataset.Options{Dir: o.CSVDir, Seasons: o.Seasons}
+}
+
+// names of the report files, relative to Options.OutDir
+const (
+	reportJSONFile = "stats.json"
+)
+
+// returns the files Run writes
+func Files(opts Options) []string {
+	opts.setDefaults()
+	files := []string{filepath.Join(opts.OutDir, reportJSONFile)}
+	for _, name := range append(summaryFiles, dailyDoubleFiles...) {
+		files = append(files, filepath.Join(opts.OutDir, name))
+	}
+	return files
+}
+
+// writes stats.json with the whole report and a CSV per table
+func (r *Report) write(dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return fmt.Errorf("error creating stats folder %s: %v", dir, err)
+	}
+	jsonPath := filepath.Join(dir, reportJSONFile)
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding %s: %v", jsonPath, err)
+	}
+	if err := os.WriteFile(jsonPath, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %v", jsonPath, err)
+	}
+	for _, tables := range []map[string][][]string{r.Summary.tables(), r.DailyDoubles.tables()} {
+		for name, table := range tables {
+			if err := writeTable(filepath.Join(dir, name), table); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writes rows, header first, to a CSV file
+func writeTable(path string, rows [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %v", path, err)
+	}
+	defer f.Close()
+	w := csv.NewWriter(f)
+	w.WriteAll(rows)
+	if err := w.Error(); err != nil {
+		return fmt.Errorf("error writing %s: %v", path, err)
+	}
+	return nil
+}
+
+// formats a rate with four decimal places
+func formatRate(x float64) string {
+	return strconv.FormatFloat(x, 'f', 4, 64)
+}
+
+// formats an average with two decimal places
+func formatAverage(x float64) string {
+	return strconv.FormatFloat(x, 'f', 2, 64)
+}
+
+// returns n/d, or 0 when d is 0
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
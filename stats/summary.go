@@ -0,0 +1,217 @@
+package stats
+
+import (
+	"strconv"
+
+	"j-parser-go/dataset"
+)
+
+// names of the summary CSVs, relative to Options.OutDir
+var summaryFiles = []string{
+	"summary-by-season.csv",
+	"summary-by-era.csv",
+}
+
+// eras of the show, each starting on the first air date it covers
+var eras = []struct {
+	name  string
+	start string
+}{
+	{"original values", "1984-09-10"},
+	{"doubled values", "2001-11-26"},
+	{"post-Trebek", "2021-01-11"},
+}
+
+// era for games without an air date
+const unknownEra = "unknown"
+
+// SummaryStats are the headline numbers for each season and each era
+type SummaryStats struct {
+	BySeason []Summary `json:"bySeason"`
+	ByEra    []Summary `json:"byEra"`
+}
+
+// Summary totals one season or era. Averages are over revealed clues that
+// aren't Daily Doubles; the triple stumper rate is over every revealed
+// clue that isn't a Daily Double, Final Jeopardy included.
+type Summary struct {
+	// season identifier or era name
+	Group string `json:"group"`
+	Games int    `json:"games"`
+	// revealed clues in every round
+	Clues                 int     `json:"clues"`
+	JeopardyAverage       float64 `json:"jeopardyAverage"`
+	DoubleJeopardyAverage float64 `json:"doubleJeopardyAverage"`
+	DailyDoubles          int     `json:"dailyDoubles"`
+	DailyDoubleAverage    float64 `json:"dailyDoubleAverage"`
+	TripleStumpers        int     `json:"tripleStumpers"`
+	TripleStumperRate     float64 `json:"tripleStumperRate"`
+	// categories played, counting each round of each game separately
+	Categories int `json:"categories"`
+	// categories whose name had already been played in an earlier game
+	RepeatCategories int     `json:"repeatCategories"`
+	CategoryReuse    float64 `json:"categoryReuse"`
+}
+
+// running totals behind a Summary
+type summaryTotals struct {
+	Summary
+	games             map[string]bool
+	valueSum, valueN  [2]int
+	wagerSum          int
+	stumperCandidates int
+}
+
+func newTotals(group string) *summaryTotals {
+	return &summaryTotals{Summary: Summary{Group: group}, games: make(map[string]bool)}
+}
+
+// computes the summary for each season and era. clues must be in season
+// then episode order, as dataset.Load returns them, so "earlier" means earlier in
+// the dataset.
+func summarize(clues []dataset.Clue) *SummaryStats {
+	var seasons, byEra []*summaryTotals
+	seasonTotals := make(map[string]*summaryTotals)
+	eraTotals := make(map[string]*summaryTotals)
+	total := func(m map[string]*summaryTotals, list *[]*summaryTotals, group string) *summaryTotals {
+		t := m[group]
+		if t == nil {
+			t = newTotals(group)
+			m[group] = t
+			*list = append(*list, t)
+		}
+		return t
+	}
+
+	type slot struct{ season, epNum, round, category string }
+	played := make(map[slot]bool)
+	// game each category name was first played in
+	firstGame := make(map[string]string)
+	for _, c := range clues {
+		game := c.Season + "/" + c.EpisodeNumber
+		groups := []*summaryTotals{
+			total(seasonTotals, &seasons, c.Season),
+			total(eraTotals, &byEra, eraOf(c.AirDate)),
+		}
+
+		s := slot{c.Season, c.EpisodeNumber, c.Round, c.Category}
+		newSlot := c.Category != "" && !played[s]
+		repeat := false
+		if newSlot {
+			played[s] = true
+			if first, ok := firstGame[c.Category]; ok && first != game {
+				repeat = true
+			} else if !ok {
+				firstGame[c.Category] = game
+			}
+		}
+
+		for _, t := range groups {
+			if !t.games[game] {
+				t.games[game] = true
+				t.Games++
+			}
+			if newSlot {
+				t.Categories++
+				if repeat {
+					t.RepeatCategories++
+				}
+			}
+			if !c.Revealed {
+				continue
+			}
+			t.Clues++
+			if c.DailyDouble {
+				t.DailyDoubles++
+				t.wagerSum += c.Value
+				continue
+			}
+			t.stumperCandidates++
+			if c.TripleStumper {
+				t.TripleStumpers++
+			}
+			if i := boardRound(c.Round); i >= 0 && c.Value > 0 {
+				t.valueSum[i] += c.Value
+				t.valueN[i]++
+			}
+		}
+	}
+
+	st := &SummaryStats{BySeason: finishTotals(seasons), ByEra: []Summary{}}
+	// eras in chronological order, whatever order the seasons came in
+	for _, era := range append(eraNames(), unknownEra) {
+		if t := eraTotals[era]; t != nil {
+			st.ByEra = append(st.ByEra, finishTotals([]*summaryTotals{t})...)
+		}
+	}
+	return st
+}
+
+// fills in the averages and rates
+func finishTotals(totals []*summaryTotals) []Summary {
+	summaries := []Summary{}
+	for _, t := range totals {
+		t.JeopardyAverage = ratio(t.valueSum[0], t.valueN[0])
+		t.DoubleJeopardyAverage = ratio(t.valueSum[1], t.valueN[1])
+		t.DailyDoubleAverage = ratio(t.wagerSum, t.DailyDoubles)
+		t.TripleStumperRate = ratio(t.TripleStumpers, t.stumperCandidates)
+		t.CategoryReuse = ratio(t.RepeatCategories, t.Categories)
+		summaries = append(summaries, t.Summary)
+	}
+	return summaries
+}
+
+// returns the index of a board round in boardRounds, or -1
+func boardRound(round string) int {
+	for i, r := range boardRounds {
+		if r == round {
+			return i
+		}
+	}
+	return -1
+}
+
+// returns the era a game aired in
+func eraOf(airDate string) string {
+	if airDate == "" {
+		return unknownEra
+	}
+	era := unknownEra
+	for _, e := range eras {
+		// YYYY-MM-DD sorts as a string
+		if airDate >= e.start {
+			era = e.name
+		}
+	}
+	return era
+}
+
+func eraNames() []string {
+	var names []string
+	for _, e := range eras {
+		names = append(names, e.name)
+	}
+	return names
+}
+
+// returns the CSV tables keyed by file name
+func (st *SummaryStats) tables() map[string][][]string {
+	return map[string][][]string{
+		summaryFiles[0]: summaryTable("season", st.BySeason),
+		summaryFiles[1]: summaryTable("era", st.ByEra),
+	}
+}
+
+func summaryTable(group string, summaries []Summary) [][]string {
+	rows := [][]string{{group, "games", "clues", "jeopardy_average", "double_jeopardy_average",
+		"daily_doubles", "daily_double_average", "triple_stumpers", "triple_stumper_rate",
+		"categories", "repeat_categories", "category_reuse"}}
+	for _, s := range summaries {
+		rows = append(rows, []string{s.Group, strconv.Itoa(s.Games), strconv.Itoa(s.Clues),
+			formatAverage(s.JeopardyAverage), formatAverage(s.DoubleJeopardyAverage),
+			strconv.Itoa(s.DailyDoubles), formatAverage(s.DailyDoubleAverage),
+			strconv.Itoa(s.TripleStumpers), formatRate(s.TripleStumperRate),
+			strconv.Itoa(s.Categories), strconv.Itoa(s.RepeatCategories), formatRate(s.CategoryReuse)})
+	}
+	return rows
+}
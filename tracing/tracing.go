@@ -0,0 +1,78 @@
+// Package tracing exports OpenTelemetry spans of per-game downloads and
+// parses over OTLP, for working out why a scheduled run was slow or failed.
+// Until Setup finds an endpoint the spans go nowhere.
+package tracing
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// how long Setup's shutdown waits for the last spans to be sent
+const flushTimeout = 5 * time.Second
+
+// values for the outcome attribute of a span
+const (
+	Downloaded = "downloaded"
+	Rejected   = "rejected"
+	Parsed     = "parsed"
+	Failed     = "failed"
+)
+
+// returns the tracer for a package, e.g. "j-parser-go/download"
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// exports spans over OTLP/HTTP to endpoint, a URL such as
+// http://localhost:4318, or to the one the standard OTEL_EXPORTER_OTLP_*
+// variables name when endpoint is empty. With neither nothing is set up.
+// The returned function sends the spans still buffered; it has to be called
+// before the process exits.
+func Setup(ctx context.Context, endpoint string) (shutdown func() error, err error) {
+	if endpoint == "" && os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return func() error { return nil }, nil
+	}
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES win over the default name
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", "jarchive")),
+		resource.WithFromEnv(), resource.WithHost(), resource.WithProcessPID())
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), flushTimeout)
+		defer cancel()
+		return provider.Shutdown(ctx)
+	}, nil
+}
+
+// records the outcome of the operation span covers, and err if it failed,
+// then ends it
+func End(span trace.Span, outcome string, err error) {
+	span.SetAttributes(attribute.String("outcome", outcome))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+
+	"j-parser-go/upload"
+)
+
+// uploadFlags are shared by the commands that can publish the files they
+// write to object storage
+type uploadFlags struct {
+	s3        upload.S3Options
+	s3Prefix  string
+	gcs       upload.GCSOptions
+	gcsPrefix string
+}
+
+func registerUploadFlags(fs *flag.FlagSet) *uploadFlags {
+	uf := &uploadFlags{}
+	fs.StringVar(&uf.s3.Bucket, "s3-bucket", "", "Upload the files written to this S3 or S3-compatible bucket")
+	fs.StringVar(&uf.s3.Endpoint, "s3-endpoint", upload.DefaultS3Endpoint, "S3 service to upload to, as host[:port] or a URL (http:// for no TLS)")
+	fs.StringVar(&uf.s3.Region, "s3-region", "", "Region of -s3-bucket (default: asked of the service)")
+	fs.StringVar(&uf.s3Prefix, "s3-prefix", "", "Key prefix of the uploaded files, e.g. datasets/jeopardy")
+	fs.StringVar(&uf.s3.AccessKey, "s3-access-key", "", "Access key for -s3-bucket (default: AWS_ACCESS_KEY_ID, ~/.aws/credentials or the IAM role)")
+	fs.StringVar(&uf.s3.SecretKey, "s3-secret-key", "", "Secret key for -s3-bucket (default: AWS_SECRET_ACCESS_KEY, ~/.aws/credentials or the IAM role)")
+	fs.StringVar(&uf.gcs.Bucket, "gcs-bucket", "", "Upload the files written to this Google Cloud Storage bucket")
+	fs.StringVar(&uf.gcsPrefix, "gcs-prefix", "", "Object name prefix of the files uploaded to -gcs-bucket")
+	fs.StringVar(&uf.gcs.CredentialsFile, "gcs-credentials", "", "Service account key file for -gcs-bucket (default: Application Default Credentials)")
+	return uf
+}
+
+// a bucket to upload to and the prefix to upload under
+type uploadTarget struct {
+	bucket upload.Bucket
+	prefix string
+}
+
+// returns the buckets to upload to, none if no bucket is set
+func (uf *uploadFlags) targets(e *env) ([]uploadTarget, error) {
+	if e.fromConfig("s3-bucket") && e.cfg.S3.Bucket != "" {
+		uf.s3.Bucket = e.cfg.S3.Bucket
+	}
+	if e.fromConfig("s3-endpoint") && e.cfg.S3.Endpoint != "" {
+		uf.s3.Endpoint = e.cfg.S3.Endpoint
+	}
+	if e.fromConfig("s3-region") && e.cfg.S3.Region != "" {
+		uf.s3.Region = e.cfg.S3.Region
+	}
+	if e.fromConfig("s3-prefix") && e.cfg.S3.Prefix != "" {
+		uf.s3Prefix = e.cfg.S3.Prefix
+	}
+	if e.fromConfig("gcs-bucket") && e.cfg.GCS.Bucket != "" {
+		uf.gcs.Bucket = e.cfg.GCS.Bucket
+	}
+	if e.fromConfig("gcs-prefix") && e.cfg.GCS.Prefix != "" {
+		uf.gcsPrefix = e.cfg.GCS.Prefix
+	}
+	if e.fromConfig("gcs-credentials") && e.cfg.GCS.Credentials != "" {
+		uf.gcs.CredentialsFile = e.cfg.GCS.Credentials
+	}
+
+	var targets []uploadTarget
+	if uf.s3.Bucket != "" {
+		b, err := upload.NewS3(uf.s3)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, uploadTarget{b, uf.s3Prefix})
+	}
+	if uf.gcs.Bucket != "" {
+		b, err := upload.NewGCS(context.Background(), uf.gcs)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, uploadTarget{b, uf.gcsPrefix})
+	}
+	return targets, nil
+}
+
+// uploads the files in dir to every bucket set
+func (uf *uploadFlags) uploadDir(e *env, dir string) error {
+	return uf.upload(e, func(t uploadTarget) error {
+		n, err := upload.Dir(context.Background(), t.bucket, dir, t.prefix)
+		if err != nil {
+			return err
+		}
+		slog.Info("upload complete", "dir", dir, "files", n, "url", t.bucket.URL(t.prefix))
+		return nil
+	})
+}
+
+// uploads file to every bucket set
+func (uf *uploadFlags) uploadFile(e *env, file string) error {
+	return uf.upload(e, func(t uploadTarget) error {
+		return upload.File(context.Background(), t.bucket, file, t.prefix)
+	})
+}
+
+func (uf *uploadFlags) upload(e *env, put func(uploadTarget) error) error {
+	targets, err := uf.targets(e)
+	if err != nil {
+		return err
+	}
+	for _, t := range targets {
+		// stop uploading at the first error, closing every bucket
+		if err == nil {
+			err = put(t)
+		}
+		err = errors.Join(err, t.bucket.Close())
+	}
+	return err
+}
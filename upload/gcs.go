@@ -0,0 +1,70 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// GCSOptions configure a Google Cloud Storage bucket
+type GCSOptions struct {
+	Bucket string
+	// a service account key file to authenticate with. If empty,
+	// Application Default Credentials are used: GOOGLE_APPLICATION_CREDENTIALS,
+	// gcloud auth application-default login or the VM's or job's service
+	// account.
+	CredentialsFile string
+}
+
+type gcsBucket struct {
+	client *storage.Client
+	bucket string
+}
+
+// returns the bucket opts describe. STORAGE_EMULATOR_HOST points it at an
+// emulator instead of Google.
+func NewGCS(ctx context.Context, opts GCSOptions) (Bucket, error) {
+	if opts.Bucket == "" {
+		return nil, errors.New("no GCS bucket given")
+	}
+	var clientOpts []option.ClientOption
+	if opts.CredentialsFile != "" {
+		clientOpts = append(clientOpts, option.WithAuthCredentialsFile(option.ServiceAccount, opts.CredentialsFile))
+	}
+	client, err := storage.NewClient(ctx, clientOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to Google Cloud Storage: %v", err)
+	}
+	return &gcsBucket{client: client, bucket: opts.Bucket}, nil
+}
+
+func (b *gcsBucket) Put(ctx context.Context, key, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := b.client.Bucket(b.bucket).Object(key).NewWriter(ctx)
+	w.ContentType = contentType(file)
+	if _, err := io.Copy(w, f); err != nil {
+		w.Close()
+		return fmt.Errorf("error uploading %s to %s: %v", file, b.URL(key), err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error uploading %s to %s: %v", file, b.URL(key), err)
+	}
+	return nil
+}
+
+func (b *gcsBucket) URL(key string) string {
+	return "gs://" + b.bucket + "/" + key
+}
+
+func (b *gcsBucket) Close() error {
+	return b.client.Close()
+}
@@ -0,0 +1,102 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// DefaultS3Endpoint is used when S3Options.Endpoint is empty
+const DefaultS3Endpoint = "s3.amazonaws.com"
+
+// S3Options configure an S3 or S3-compatible bucket, such as MinIO, R2 or
+// B2
+type S3Options struct {
+	// host[:port] of the service, or its URL; an http:// URL turns TLS off,
+	// as for a local MinIO. DefaultS3Endpoint if empty.
+	Endpoint string
+	// the bucket's region; worked out by the service if empty
+	Region string
+	Bucket string
+	// keys to sign requests with. If both are empty they're taken from the
+	// environment (AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY, or
+	// MINIO_ACCESS_KEY and MINIO_SECRET_KEY), ~/.aws/credentials or the
+	// instance's IAM role, in that order.
+	AccessKey string
+	SecretKey string
+}
+
+type s3Bucket struct {
+	client *minio.Client
+	bucket string
+}
+
+// returns the bucket opts describe
+func NewS3(opts S3Options) (Bucket, error) {
+	if opts.Bucket == "" {
+		return nil, errors.New("no S3 bucket given")
+	}
+	endpoint, secure := opts.Endpoint, true
+	if endpoint == "" {
+		endpoint = DefaultS3Endpoint
+	}
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid S3 endpoint %q: %v", endpoint, err)
+		}
+		endpoint, secure = u.Host, u.Scheme != "http"
+	}
+	creds := credentials.NewStaticV4(opts.AccessKey, opts.SecretKey, "")
+	if opts.AccessKey == "" && opts.SecretKey == "" {
+		creds = credentials.NewChainCredentials([]credentials.Provider{
+			&credentials.EnvAWS{},
+			&credentials.EnvMinio{},
+			&credentials.FileAWSCredentials{},
+			&credentials.IAM{},
+		})
+	}
+	client, err := minio.New(endpoint, &minio.Options{Creds: creds, Secure: secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Bucket{client: client, bucket: opts.Bucket}, nil
+}
+
+func (b *s3Bucket) Put(ctx context.Context, key, file string) error {
+	_, err := b.client.FPutObject(ctx, b.bucket, key, file, minio.PutObjectOptions{ContentType: contentType(file)})
+	if err != nil {
+		return fmt.Errorf("error uploading %s to %s: %v", file, b.URL(key), err)
+	}
+	return nil
+}
+
+func (b *s3Bucket) URL(key string) string {
+	return "s3://" + b.bucket + "/" + key
+}
+
+func (b *s3Bucket) Close() error {
+	return nil
+}
+
+// returns the MIME type for a file's extension, e.g. text/csv
+func contentType(file string) string {
+	switch ext := filepath.Ext(file); ext {
+	case ".csv":
+		return "text/csv; charset=utf-8"
+	case ".arrow":
+		return "application/vnd.apache.arrow.file"
+	default:
+		if t := mime.TypeByExtension(ext); t != "" {
+			return t
+		}
+		return "application/octet-stream"
+	}
+}
@@ -0,0 +1,72 @@
+// Package upload publishes the files the commands write, such as the season
+// CSVs, to object storage.
+package upload
+
+import (
+	"context"
+	"io/fs"
+	"log/slog"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Bucket is object storage files are uploaded to
+type Bucket interface {
+	// stores the file at file under key, replacing what was there
+	Put(ctx context.Context, key, file string) error
+	// returns where key is stored, e.g. "s3://bucket/key", for logging
+	URL(key string) string
+	// releases the bucket's connections
+	Close() error
+}
+
+// uploads every file under dir to b, keyed by prefix and the file's path
+// relative to dir. Hidden files and folders, such as parse's incremental
+// state, and partial files still being written are left out. Returns how
+// many files were uploaded.
+func Dir(ctx context.Context, b Bucket, dir, prefix string) (int, error) {
+	n := 0
+	err := filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") && file != dir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() || strings.HasSuffix(d.Name(), ".partial") {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, file)
+		if err != nil {
+			return err
+		}
+		if err := put(ctx, b, key(prefix, filepath.ToSlash(rel)), file); err != nil {
+			return err
+		}
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// uploads file to b, keyed by prefix and the file's name
+func File(ctx context.Context, b Bucket, file, prefix string) error {
+	return put(ctx, b, key(prefix, filepath.Base(file)), file)
+}
+
+func put(ctx context.Context, b Bucket, key, file string) error {
+	if err := b.Put(ctx, key, file); err != nil {
+		return err
+	}
+	slog.Info("uploaded file", "file", file, "url", b.URL(key))
+	return nil
+}
+
+// joins prefix and name with a slash, leaving no leading one
+func key(prefix, name string) string {
+	return strings.TrimPrefix(path.Join(prefix, name), "/")
+}
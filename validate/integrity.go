@@ -0,0 +1,124 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"j-parser-go/jarchive"
+)
+
+// clues on a full board
+const boardClues = 30
+
+// the most clues a game has: two full boards and Final Jeopardy, plus a
+// board for Triple Jeopardy and a tiebreaker clue in games that had them
+const maxGameClues = 2*boardClues + 1
+
+// the shape of an air date, which the schema checks too
+var airDateRe = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+
+// gameKey is a game of an input file, as its rows name it
+type gameKey struct{ season, episode string }
+
+// positionKey is where a clue was on the board of its game; Final Jeopardy
+// and the tiebreaker are at column and row 0
+type positionKey struct {
+	game        gameKey
+	round       string
+	column, row string
+}
+
+// gameCount tallies one game's rows
+type gameCount struct {
+	// line of its first row
+	first                 int
+	clues                 int
+	tripleJeopardy, extra bool
+}
+
+// checks what the schema can't see row by row: that every game has no
+// more clues than fit on its boards, that no two clues share a position,
+// that revealed clues have their category, clue and response, and that air
+// dates are real dates
+func checkIntegrity(file string, rows []row) []Problem {
+	var problems []Problem
+	add := func(n int, format string, args ...any) {
+		problems = append(problems, Problem{File: file, Row: n, Message: fmt.Sprintf(format, args...)})
+	}
+	var order []gameKey
+	games := make(map[gameKey]*gameCount)
+	seen := make(map[positionKey]int)
+	for _, r := range rows {
+		game := gameKey{str(r.fields, "season"), str(r.fields, "epNum")}
+		round := str(r.fields, "round_name")
+		g := games[game]
+		if g == nil {
+			g = &gameCount{first: r.n}
+			games[game] = g
+			order = append(order, game)
+		}
+		g.clues++
+		g.tripleJeopardy = g.tripleJeopardy || round == jarchive.RoundTripleJeopardy
+		g.extra = g.extra || round == jarchive.RoundTiebreaker
+
+		pos := positionKey{game, round, str(r.fields, "board_column"), str(r.fields, "board_row")}
+		if prev, ok := seen[pos]; ok {
+			add(r.n, "duplicate clue: %s", describePosition(pos, prev))
+		} else {
+			seen[pos] = r.n
+		}
+
+		revealed, ok := r.fields["revealed"].(bool)
+		if !ok || revealed {
+			for _, name := range []string{"category", "question", "answer"} {
+				if str(r.fields, name) == "" {
+					add(r.n, "/%s: missing from a revealed clue", name)
+				}
+			}
+		}
+		if date := str(r.fields, "airDate"); airDateRe.MatchString(date) {
+			if _, err := time.Parse(time.DateOnly, date); err != nil {
+				add(r.n, "/airDate: %q is not a date", date)
+			}
+		}
+	}
+	for _, game := range order {
+		g := games[game]
+		limit := maxGameClues
+		if g.tripleJeopardy {
+			limit += boardClues
+		}
+		if g.extra {
+			limit++
+		}
+		if g.clues > limit {
+			add(g.first, "season %s show %s has %d clues, more than the %d its rounds have room for", game.season, game.episode, g.clues, limit)
+		}
+	}
+	return problems
+}
+
+// names a clue's position and the line of the clue that was there first
+func describePosition(pos positionKey, prev int) string {
+	where := pos.round
+	if pos.column != "" || pos.row != "" {
+		where += " column " + pos.column + " row " + pos.row
+	}
+	return fmt.Sprintf("%s of season %s show %s is also on row %d", where, pos.game.season, pos.game.episode, prev)
+}
+
+// returns a field as text, whatever its JSON type
+func str(fields map[string]any, name string) string {
+	switch v := fields[name].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
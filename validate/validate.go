@@ -0,0 +1,260 @@
+// Package validate checks parse output against the JSON Schema of a clue,
+// for integrations that want to pin down what they read, and for the
+// anomalies a row-by-row schema can't see, such as duplicate clues.
+package validate
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"j-parser-go/parse"
+)
+
+// Schema is the JSON Schema of one row of a season CSV, as an object keyed
+// by column name with numbers and booleans as JSON ones
+//
+//go:embed clue.schema.json
+var Schema []byte
+
+// the column types of Schema, by column name
+var columnTypes = func() map[string]string {
+	var s struct {
+		Properties map[string]struct {
+			Type string `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(Schema, &s); err != nil {
+		panic(err)
+	}
+	types := make(map[string]string)
+	for name, p := range s.Properties {
+		types[name] = p.Type
+	}
+	return types
+}()
+
+// Options controls what Run checks
+type Options struct {
+	// a parse output directory, whose season CSVs are checked, or a single
+	// season CSV or JSON file of rows; "parsed-csv" if empty
+	In string
+}
+
+// fills in defaults for unset options
+func (o *Options) setDefaults() {
+	if o.In == "" {
+		o.In = "parsed-csv"
+	}
+}
+
+// Report is what Run found
+type Report struct {
+	Files int
+	Rows  int
+	// in file order, then row order
+	Problems []Problem
+}
+
+// Problem is a row that doesn't match the schema or fails an integrity
+// check
+type Problem struct {
+	File string
+	// line of the CSV, or position in the JSON array counting from 1
+	Row int
+	// where in the row, e.g. "/value", and what is wrong there
+	Message string
+}
+
+// checks every row of the selected files against Schema, and each file's
+// games for too many clues, clues sharing a board position, revealed clues
+// missing their text and air dates that aren't dates
+func Run(opts Options) (*Report, error) {
+	opts.setDefaults()
+	files, err := inputFiles(opts.In)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := compile()
+	if err != nil {
+		return nil, err
+	}
+	rep := &Report{}
+	for _, file := range files {
+		rows, err := readRows(file)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %v", file, err)
+		}
+		rep.Files++
+		var problems []Problem
+		for _, row := range rows {
+			rep.Rows++
+			for _, msg := range check(schema, row.fields) {
+				problems = append(problems, Problem{File: file, Row: row.n, Message: msg})
+			}
+		}
+		problems = append(problems, checkIntegrity(file, rows)...)
+		sort.SliceStable(problems, func(i, j int) bool { return problems[i].Row < problems[j].Row })
+		rep.Problems = append(rep.Problems, problems...)
+	}
+	return rep, nil
+}
+
+// returns the season CSVs of a parse output directory, in season order, or
+// in itself a file
+func inputFiles(in string) ([]string, error) {
+	info, err := os.Stat(in)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{in}, nil
+	}
+	if schema, err := parse.ReadSchema(in); err == nil && schema != nil && schema.Layout == parse.LayoutNormalized {
+		return nil, fmt.Errorf("%s holds the normalized layout; only season CSVs can be validated", in)
+	}
+	seasons, err := parse.ParsedSeasons(parse.Options{OutDir: in})
+	if err != nil {
+		return nil, err
+	}
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("no season CSVs in %s", in)
+	}
+	var files []string
+	for _, season := range seasons {
+		files = append(files, parse.CSVPath(parse.Options{OutDir: in}, season))
+	}
+	return files, nil
+}
+
+// compiles Schema
+func compile() (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(Schema))
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource("clue.schema.json", doc); err != nil {
+		return nil, err
+	}
+	return c.Compile("clue.schema.json")
+}
+
+// row is one record of an input file
+type row struct {
+	n      int
+	fields map[string]any
+}
+
+// reads a JSON array of rows, or a CSV with typed values for the columns
+// Schema gives a type and without its empty cells
+func readRows(file string) ([]row, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		var records []map[string]any
+		dec := json.NewDecoder(f)
+		dec.UseNumber()
+		if err := dec.Decode(&records); err != nil {
+			return nil, err
+		}
+		rows := make([]row, len(records))
+		for i, r := range records {
+			rows[i] = row{n: i + 1, fields: r}
+		}
+		return rows, nil
+	}
+
+	cr := csv.NewReader(parse.SkipBOM(f))
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+	var rows []row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		fields := make(map[string]any)
+		for i, cell := range record {
+			name := strconv.Itoa(i + 1)
+			if i < len(header) {
+				name = header[i]
+			}
+			if cell != "" {
+				fields[name] = typed(columnTypes[name], cell)
+			}
+		}
+		rows = append(rows, row{n: line, fields: fields})
+	}
+}
+
+// converts a CSV cell to the JSON type of its column, leaving it a string
+// when it isn't one, which the schema then reports
+func typed(kind, cell string) any {
+	switch kind {
+	case "integer", "number":
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return json.Number(cell)
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(cell); err == nil && (cell == "true" || cell == "false") {
+			return b
+		}
+	}
+	return cell
+}
+
+var printer = message.NewPrinter(language.English)
+
+// returns what is wrong with a row, one message per failed keyword
+func check(schema *jsonschema.Schema, fields map[string]any) []string {
+	err := schema.Validate(fields)
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return nil
+	}
+	var msgs []string
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			msgs = append(msgs, "/"+strings.Join(e.InstanceLocation, "/")+": "+e.ErrorKind.LocalizedString(printer))
+		}
+		for _, c := range e.Causes {
+			walk(c)
+		}
+	}
+	walk(verr)
+	sort.Strings(msgs)
+	return msgs
+}
+
+// writes each problem as "file:row: message", then a summary line
+func (r *Report) Write(w io.Writer) {
+	for _, p := range r.Problems {
+		fmt.Fprintf(w, "%s:%d: %s\n", p.File, p.Row, p.Message)
+	}
+	fmt.Fprintf(w, "%d rows in %d files checked, %d problems\n", r.Rows, r.Files, len(r.Problems))
+}
@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"j-parser-go/internal/golden"
+)
+
+// validates each CSV and JSON file in testdata, which break the schema or
+// the integrity checks on purpose, and compares the report with
+// testdata/<file>.golden.txt
+func TestGoldenReports(t *testing.T) {
+	var inputs []string
+	for _, pattern := range []string{"*.csv", "*.json"} {
+		files, err := filepath.Glob(filepath.Join("testdata", pattern))
+		if err != nil {
+			t.Fatal(err)
+		}
+		inputs = append(inputs, files...)
+	}
+	if len(inputs) == 0 {
+		t.Fatal("no inputs in testdata")
+	}
+	for _, in := range inputs {
+		name := filepath.Base(in)
+		t.Run(name, func(t *testing.T) {
+			rep, err := Run(Options{In: in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rep.Problems) == 0 {
+				t.Errorf("no problems found in %s", in)
+			}
+			var got bytes.Buffer
+			rep.Write(&got)
+			golden.Compare(t, in+".golden.txt", got.Bytes())
+		})
+	}
+}
+
+// checks that the parse package's golden CSVs, as a parse output directory,
+// have no problems
+func TestGoldenSeasonsValid(t *testing.T) {
+	rep, err := Run(Options{In: golden.Seasons(t)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Files != 8 || rep.Rows == 0 {
+		t.Errorf("checked %d rows in %d files, want the 8 golden CSVs", rep.Rows, rep.Files)
+	}
+	var buf bytes.Buffer
+	rep.Write(&buf)
+	if len(rep.Problems) != 0 {
+		t.Errorf("problems in the golden CSVs:\n%s", buf.String())
+	}
+}